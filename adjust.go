@@ -0,0 +1,57 @@
+package binbump
+
+import "math"
+
+// Adjust returns a copy of the colorset with the gamma, brightness and saturation adjusted,
+// so renders can be tuned to match photographs of CRT monitors or a calibrated display.
+// Each value is a factor where 1 leaves the colors unchanged.
+//
+// A gamma above 1 lightens the mid-tones and below 1 darkens them, the brightness
+// multiplies the channel values, and a saturation of 0 returns shades of gray while
+// above 1 intensifies the colors. A gamma <= 0 is ignored, and negative brightness
+// and saturation values are treated as 0.
+//
+// To render a grid using the adjusted colors, pass the result to [Grid.SetColors].
+//
+//nolint:mnd
+func (c Colors) Adjust(gamma, brightness, saturation float64) Colors {
+	if gamma <= 0 {
+		gamma = 1
+	}
+	brightness = max(brightness, 0)
+	saturation = max(saturation, 0)
+	var adj Colors
+	for i, color := range c {
+		r, g, b := color.RGB()
+		rgb := [3]float64{float64(r) / 255, float64(g) / 255, float64(b) / 255}
+		for j, v := range rgb {
+			rgb[j] = math.Pow(v, 1/gamma) * brightness
+		}
+		luma := 0.299*rgb[0] + 0.587*rgb[1] + 0.114*rgb[2]
+		var out [3]uint8
+		for j, v := range rgb {
+			v = luma + (v-luma)*saturation
+			out[j] = uint8(math.Round(min(max(v, 0), 1) * 255))
+		}
+		adj[i] = rgbColor(out[0], out[1], out[2])
+	}
+	return adj
+}
+
+// Blend returns the colorset interpolated between the a and b colorsets, where a t of 0
+// returns the colors of a, 1 returns the colors of b, and 0.5 is halfway between them,
+// such as for a slider between the [CGA] and [CGARevised] colorsets.
+// The t is clamped between 0 and 1.
+func Blend(a, b Colors, t float64) Colors {
+	t = min(max(t, 0), 1)
+	mix := func(x, y uint8) uint8 {
+		return uint8(math.Round(float64(x) + (float64(y)-float64(x))*t))
+	}
+	var out Colors
+	for i := range out {
+		ar, ag, ab := a[i].RGB()
+		br, bg, bb := b[i].RGB()
+		out[i] = rgbColor(mix(ar, br), mix(ag, bg), mix(ab, bb))
+	}
+	return out
+}
@@ -0,0 +1,21 @@
+package binbump_test
+
+import (
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleColors_Adjust() {
+	cga := binbump.CGA()
+	fmt.Println(cga.Adjust(1, 1, 1)[6])
+	fmt.Println(cga.Adjust(1.2, 1, 1)[6], cga.Adjust(1, 0.8, 1)[6], cga.Adjust(1, 1, 0)[6])
+	// Output: aa5500
+	// b66600 884400 656565
+}
+
+func ExampleBlend() {
+	c := binbump.Blend(binbump.CGA(), binbump.CGARevised(), 0.5)
+	fmt.Println(c[1], c[6], c[15])
+	// Output: 0000b7 b76a00 ffffff
+}
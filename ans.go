@@ -0,0 +1,117 @@
+package binbump
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ansState is the graphic rendition of the cursor in an ANSI art file.
+type ansState struct {
+	fg, bg      uint8
+	bold, blink bool
+}
+
+// ansDefault is the graphic rendition after a reset, gray on black.
+//
+//nolint:gochecknoglobals
+var ansDefault = ansState{fg: 7, bg: 0}
+
+// WriteANS writes to w the grid as an ANSI art file, as used by DOS era viewers and editors,
+// so the screen can be used with existing ANSI tooling. Unlike [Grid.WriteANSI] that is
+// intended for terminals, the characters are kept in the charset of the grid and the
+// intense colors use the bold attribute.
+//
+// The escape sequences are minimal, only changed attributes are written, blank cells
+// at the end of a row are trimmed and other runs of blank cells become cursor forward
+// sequences. Rows end with a CR LF, except for full rows that wrap to the next line.
+// The control characters that an ANSI driver would act upon are replaced by spaces.
+func (g *Grid) WriteANS(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	const minForward = 4 // a cursor forward sequence is shorter than 4 or more spaces
+	out := bufio.NewWriter(w)
+	state := ansDefault
+	for _, row := range g.rows {
+		end := len(row)
+		for end > 0 && g.ansBlank(row[end-1]) {
+			end--
+		}
+		for x := 0; x < end; x++ {
+			blanks := 0
+			for x+blanks < end && g.ansBlank(row[x+blanks]) {
+				blanks++
+			}
+			if blanks >= minForward {
+				fmt.Fprintf(out, "\x1b[%dC", blanks)
+				x += blanks - 1
+				continue
+			}
+			c := row[x]
+			out.WriteString(state.sgr(c))
+			fg, bg := c.Colors()
+			state = ansState{fg: fg, bg: bg, bold: fg >= 8, blink: c.Blink()}
+			out.WriteByte(ansChar(c.Char))
+		}
+		if end < g.width {
+			out.WriteString("\r\n")
+		}
+	}
+	out.WriteString("\x1b[0m")
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("write ans flush: %w", err)
+	}
+	return nil
+}
+
+// sgr returns the shortest select graphic rendition escape sequence that changes
+// the state to the attribute of the cell, or an empty string if nothing changes.
+func (s ansState) sgr(c Cell) string {
+	const fgBase, bgBase, intensity = 30, 40, 8
+	fg, bg := c.Colors()
+	next := ansState{fg: fg, bg: bg, bold: fg >= intensity, blink: c.Blink()}
+	if next == s {
+		return ""
+	}
+	params := []string{}
+	// bold and blink can only be turned off with a reset
+	if (s.bold && !next.bold) || (s.blink && !next.blink) {
+		params = append(params, "0")
+		s = ansDefault
+	}
+	if next.bold && !s.bold {
+		params = append(params, "1")
+	}
+	if next.blink && !s.blink {
+		params = append(params, "5")
+	}
+	if next.fg%intensity != s.fg%intensity {
+		params = append(params, strconv.Itoa(fgBase+ansiColors[next.fg%intensity]))
+	}
+	if next.bg != s.bg {
+		params = append(params, strconv.Itoa(bgBase+ansiColors[next.bg%intensity]))
+	}
+	if len(params) == 0 {
+		return ""
+	}
+	return "\x1b[" + strings.Join(params, ";") + "m"
+}
+
+// ansBlank reports whether the cell is an empty [Grid.Blank] cell on a black background
+// that does not blink, which is the same as a cell skipped by the cursor.
+func (g *Grid) ansBlank(c Cell) bool {
+	return g.emptyCell(c) && !c.Blink()
+}
+
+// ansChar returns the character code, or a space if it is a control character
+// that an ANSI driver would act upon.
+func ansChar(b byte) byte {
+	switch b {
+	case 0x00, 0x07, 0x08, 0x09, 0x0a, 0x0d, 0x1a, 0x1b:
+		return ' '
+	}
+	return b
+}
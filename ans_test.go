@@ -0,0 +1,45 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_WriteANS() {
+	data := []byte{
+		0x48, 0x1e, 0x69, 0x1e, 0x20, 0x00, 0x20, 0x00, 0x20, 0x00, 0x20, 0x00,
+		0x21, 0x07, 0xdb, 0x04, 0x20, 0x07, 0x20, 0x07,
+	}
+	d := binbump.NewDecoder(10, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	var b bytes.Buffer
+	if err := d.Grid().WriteANS(&b); err != nil {
+		panic(err)
+	}
+	fmt.Printf("%q", b.String())
+	// Output: "\x1b[1;33;44mHi\x1b[4C\x1b[0m!\x1b[31m\xdb\r\n\x1b[0m"
+}
+
+func ExampleGrid_WriteANS_setBlank() {
+	// the full blocks are black on black, which the art group treats as blank
+	data := []byte{0x48, 0x07, 0xdb, 0x00, 0xdb, 0x00}
+	d := binbump.NewDecoder(4, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	g := d.Grid()
+	g.SetBlank(func(c binbump.Cell) bool {
+		fg, bg := c.Colors()
+		return c.Char == 0x20 || (c.Char == 0xdb && fg == bg)
+	})
+	var b bytes.Buffer
+	if err := g.WriteANS(&b); err != nil {
+		panic(err)
+	}
+	fmt.Printf("%q", b.String())
+	// Output: "H\r\n\x1b[0m"
+}
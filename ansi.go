@@ -0,0 +1,132 @@
+package binbump
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ColorMode sets the color depth of the ANSI escape sequences written for terminals.
+type ColorMode uint
+
+const (
+	// Color16 uses the basic 16-color SGR sequences, which are displayed using the
+	// palette of the terminal.
+	Color16 ColorMode = iota
+	// TrueColor uses the 24-bit color SGR sequences, 38;2 and 48;2,
+	// with the exact RGB values of the grid palette.
+	TrueColor
+	// Color256 uses the 256-color SGR sequences, 38;5 and 48;5, with the xterm color index
+	// nearest to the RGB values of the grid palette, for terminals without 24-bit color.
+	Color256
+)
+
+// ansiColors maps the 4-bit color codes to the ANSI color order,
+// as the IBM PC swaps the red and blue bits.
+//
+//nolint:gochecknoglobals
+var ansiColors = [8]int{0, 4, 2, 6, 1, 5, 3, 7}
+
+// WriteANSI writes to w the grid as UTF-8 text with ANSI escape sequences
+// for display in a terminal, using the color depth of the mode.
+// Each row is a line that ends with an attribute reset.
+func (g *Grid) WriteANSI(w io.Writer, mode ColorMode) error {
+	if w == nil {
+		w = io.Discard
+	}
+	out := bufio.NewWriter(w)
+	for _, row := range g.rows {
+		attr := -1
+		for _, c := range row {
+			if int(c.Attr) != attr {
+				out.WriteString(g.sgr(c, mode))
+				attr = int(c.Attr)
+			}
+			out.WriteRune(textRune(g.rune(c)))
+		}
+		out.WriteString("\x1b[0m\n")
+	}
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("write ansi flush: %w", err)
+	}
+	return nil
+}
+
+// sgr returns the select graphic rendition escape sequence for the cell attribute.
+func (g *Grid) sgr(c Cell, mode ColorMode) string {
+	const (
+		fgBase, fgBright, bgBase = 30, 90, 40
+		intensity                = 8
+	)
+	fg, bg := g.attrColors(c)
+	s := "\x1b[0"
+	if c.Blink() {
+		s += ";5"
+	}
+	if g.underline(c) {
+		s += ";4"
+	}
+	switch mode {
+	case TrueColor:
+		r, gr, b := g.colors[fg].RGB()
+		s += fmt.Sprintf(";38;2;%d;%d;%d", r, gr, b)
+		r, gr, b = g.colors[bg].RGB()
+		s += fmt.Sprintf(";48;2;%d;%d;%d", r, gr, b)
+	case Color256:
+		s += ";38;5;" + strconv.Itoa(xterm256(g.colors[fg].RGB()))
+		s += ";48;5;" + strconv.Itoa(xterm256(g.colors[bg].RGB()))
+	default:
+		if fg >= intensity {
+			s += ";" + strconv.Itoa(fgBright+ansiColors[fg-intensity])
+		} else {
+			s += ";" + strconv.Itoa(fgBase+ansiColors[fg])
+		}
+		s += ";" + strconv.Itoa(bgBase+ansiColors[bg%intensity])
+	}
+	return s + "m"
+}
+
+// xterm256 returns the xterm 256-color index nearest to the RGB values, using the 6x6x6
+// color cube and the grayscale ramp. The first 16 colors are skipped, as they are set
+// by the palette of the terminal.
+func xterm256(r, g, b uint8) int {
+	const (
+		cubeStart, grayStart = 16, 232
+		cubeSize, grays      = 6, 24
+	)
+	levels := [cubeSize]int{0, 95, 135, 175, 215, 255}
+	dist := func(lr, lg, lb int) int {
+		dr, dg, db := int(r)-lr, int(g)-lg, int(b)-lb
+		return dr*dr + dg*dg + db*db
+	}
+	// the nearest cube level of each channel gives the nearest cube color
+	nearest := func(v uint8) int {
+		best := 0
+		for i, l := range levels {
+			if abs(int(v)-l) < abs(int(v)-levels[best]) {
+				best = i
+			}
+		}
+		return best
+	}
+	ri, gi, bi := nearest(r), nearest(g), nearest(b)
+	index := cubeStart + ri*cubeSize*cubeSize + gi*cubeSize + bi
+	best := dist(levels[ri], levels[gi], levels[bi])
+	for i := range grays {
+		const grayBase, grayStep = 8, 10
+		v := grayBase + i*grayStep
+		if d := dist(v, v, v); d < best {
+			index, best = grayStart+i, d
+		}
+	}
+	return index
+}
+
+// abs returns the absolute value of n.
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,50 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_WriteANSI() {
+	data := []byte{0x48, 0x1e, 0x69, 0x1e, 0x21, 0x07, 0xdb, 0x04}
+	d := binbump.NewDecoder(0, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	var b bytes.Buffer
+	if err := d.Grid().WriteANSI(&b, binbump.Color16); err != nil {
+		panic(err)
+	}
+	fmt.Printf("%q", b.String())
+	// Output: "\x1b[0;93;44mHi\x1b[0;37;40m!\x1b[0;31;40m█\x1b[0m\n"
+}
+
+func ExampleGrid_WriteANSI_trueColor() {
+	data := []byte{0x48, 0x1e, 0x69, 0x1e}
+	d := binbump.NewDecoder(0, 0, binbump.RevisedCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	var b bytes.Buffer
+	if err := d.Grid().WriteANSI(&b, binbump.TrueColor); err != nil {
+		panic(err)
+	}
+	fmt.Printf("%q", b.String())
+	// Output: "\x1b[0;38;2;243;243;78;48;2;0;0;196mHi\x1b[0m\n"
+}
+
+func ExampleGrid_WriteANSI_color256() {
+	data := []byte{0x48, 0x1e, 0x69, 0x1e, 0x21, 0x08}
+	d := binbump.NewDecoder(0, 0, binbump.RevisedCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	var b bytes.Buffer
+	if err := d.Grid().WriteANSI(&b, binbump.Color256); err != nil {
+		panic(err)
+	}
+	fmt.Printf("%q", b.String())
+	// Output: "\x1b[0;38;5;227;48;5;20mHi\x1b[0;38;5;239;48;5;16m!\x1b[0m\n"
+}
@@ -0,0 +1,96 @@
+package binbump
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// asciiBlocks are the ASCII characters of the block and shade characters,
+// ordered by the coverage of the cell.
+//
+//nolint:gochecknoglobals
+var asciiBlocks = map[rune]rune{
+	'█': '#', '▓': '%', '▒': ':', '░': '.',
+	'▀': '"', '▄': '_', '▌': '[', '▐': ']', '■': 'o',
+}
+
+// asciiSymbols are the ASCII characters that resemble the other symbols of the DOS charsets,
+// including the glyphs displayed for the control codes.
+//
+//nolint:gochecknoglobals
+var asciiSymbols = map[rune]rune{
+	'☺': 'o', '☻': 'o', '♥': 'v', '♦': '*', '♣': '*', '♠': '*', '•': '*', '◘': '#',
+	'○': 'o', '◙': 'o', '♂': 'o', '♀': 'o', '♪': 'd', '♫': 'd', '☼': '*', '►': '>',
+	'◄': '<', '↕': '|', '‼': '!', '¶': 'P', '§': 'S', '▬': '=', '↨': '|', '↑': '^',
+	'↓': 'v', '→': '>', '←': '<', '∟': 'L', '↔': '-', '▲': '^', '▼': 'v', '⌂': '^',
+	'¢': 'c', '£': 'L', '¥': 'Y', '₧': 'P', 'ƒ': 'f', 'ª': 'a', 'º': 'o', '¿': '?',
+	'⌐': '-', '¬': '-', '½': '/', '¼': '/', '¡': '!', '«': '<', '»': '>', 'α': 'a',
+	'ß': 'B', 'Γ': 'r', 'π': 'n', 'Σ': 'E', 'σ': 'o', 'µ': 'u', 'τ': 't', 'Φ': 'O',
+	'Θ': 'O', 'Ω': 'O', 'δ': 'd', '∞': '8', 'φ': 'o', 'ε': 'e', '∩': 'n', '≡': '=',
+	'±': '+', '≥': '>', '≤': '<', '⌠': '(', '⌡': ')', '÷': '/', '≈': '~', '°': 'o',
+	'∙': '.', '·': '.', '√': 'v', 'ⁿ': 'n', '²': '2', 'æ': 'e', 'Æ': 'E',
+}
+
+// WriteASCII writes to w the grid as plain 7-bit ASCII art without colors, for
+// environments limited to ASCII text. The block and shade characters are mapped to
+// ASCII characters of a similar coverage, such as # for a full block and . for
+// a light shade, the box-drawing characters to lines of -, = and | joined by +,
+// accented letters to their base letters, and other symbols to a similar character
+// or a question mark.
+//
+// Spaces at the end of a row are trimmed, and each row ends with a newline.
+func (g *Grid) WriteASCII(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	out := bufio.NewWriter(w)
+	line := make([]byte, 0, g.width)
+	for _, row := range g.rows {
+		line = line[:0]
+		for _, c := range row {
+			line = append(line, byte(asciiRune(g.glyphRune(c))))
+		}
+		out.WriteString(strings.TrimRight(string(line), " "))
+		out.WriteByte('\n')
+	}
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("write ascii flush: %w", err)
+	}
+	return nil
+}
+
+// asciiRune returns the printable ASCII character that resembles r, or a question mark.
+func asciiRune(r rune) rune {
+	const nbsp, unknown = 0xa0, '?'
+	switch {
+	case r == nbsp, r < ' ':
+		return ' '
+	case r <= '~':
+		return r
+	}
+	if a, ok := asciiBlocks[r]; ok {
+		return a
+	}
+	if a, ok := boxDrawing[r]; ok {
+		switch {
+		case a.up == noLine && a.down == noLine && a.left == doubleLine:
+			return '='
+		case a.up == noLine && a.down == noLine:
+			return '-'
+		case a.left == noLine && a.right == noLine:
+			return '|'
+		}
+		return '+'
+	}
+	if a, ok := asciiSymbols[r]; ok {
+		return a
+	}
+	if base := []rune(norm.NFD.String(string(r))); len(base) > 0 && base[0] <= '~' {
+		return base[0]
+	}
+	return unknown
+}
@@ -0,0 +1,20 @@
+package binbump_test
+
+import (
+	"image"
+	"os"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_WriteASCII() {
+	g := binbump.NewGrid(8, 3, binbump.StandardCGA, nil)
+	g.Box(image.Rect(0, 0, 8, 3), binbump.DoubleLine, 15, 1)
+	g.Print(1, 1, "█▓▒░é", 14, 1)
+	if err := g.WriteASCII(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: +======+
+	// |#%:.e |
+	// +======+
+}
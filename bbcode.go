@@ -0,0 +1,43 @@
+package binbump
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// WriteBBCode writes to w the grid as BBCode markup for posting to forums that
+// don't accept HTML. Each run of identical colors is wrapped in a
+// [bgcolor=#rrggbb] and a [color=#rrggbb] tag, and the rows are separated by newlines.
+// Each square bracket of the text is wrapped in a [noparse] tag, so that text such as
+// [b] or [url] in the art is not parsed as markup.
+//
+// The markup should be placed within a monospaced [font] tag or similar,
+// depending on the forum software.
+func (g *Grid) WriteBBCode(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	out := bufio.NewWriter(w)
+	for _, row := range g.rows {
+		for x := 0; x < len(row); {
+			attr := row[x].Attr
+			fg, bg := g.attrColors(row[x])
+			fmt.Fprintf(out, "[bgcolor=#%s][color=#%s]", g.colors[bg].hex(), g.colors[fg].hex())
+			for ; x < len(row) && row[x].Attr == attr; x++ {
+				switch r := textRune(g.rune(row[x])); r {
+				case '[', ']':
+					out.WriteString("[noparse]" + string(r) + "[/noparse]")
+				default:
+					out.WriteRune(r)
+				}
+			}
+			out.WriteString("[/color][/bgcolor]")
+		}
+		out.WriteByte('\n')
+	}
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("write bbcode flush: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package binbump_test
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_WriteBBCode() {
+	data := []byte{0x48, 0x1e, 0x69, 0x1e, 0x21, 0x07, 0xdb, 0x04}
+	d := binbump.NewDecoder(0, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	if err := d.Grid().WriteBBCode(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: [bgcolor=#0000aa][color=#ffff55]Hi[/color][/bgcolor][bgcolor=#000000][color=#aaaaaa]![/color][/bgcolor][bgcolor=#000000][color=#aa0000]█[/color][/bgcolor]
+}
+
+func ExampleGrid_WriteBBCode_brackets() {
+	data := []byte("[\x07b\x07]\x07")
+	d := binbump.NewDecoder(0, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	if err := d.Grid().WriteBBCode(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: [bgcolor=#000000][color=#aaaaaa][noparse][[/noparse]b[noparse]][/noparse][/color][/bgcolor]
+}
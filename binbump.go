@@ -5,22 +5,73 @@ package binbump
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
-	"html"
 	"html/template"
+	"image/color"
 	"io"
-	"os"
+	"log/slog"
 	"slices"
+	"strconv"
+	"strings"
 
+	"github.com/bengarrett/binbump/sauce"
 	"golang.org/x/text/encoding/charmap"
 )
 
 var (
+	// ErrAttribute is no longer returned, as every attribute byte decodes to a 4-bit
+	// foreground and 3-bit background color.
+	//
+	// Deprecated: a [DecodeError] only wraps the errors of the Reader and the limits.
 	ErrAttribute = errors.New("attribute is not a 4-bit color value")
 	ErrReader    = errors.New("reader is nil")
+	ErrDelta     = errors.New("delta data is truncated")
+	ErrCharset   = errors.New("charset name is unknown")
+	ErrPalette   = errors.New("palette name is unknown")
+	ErrColor     = errors.New("color is not a 3 or 6 digit hexadecimal triplet")
+	ErrSeparator = errors.New("row separator is unknown")
+	ErrFont      = errors.New("font data is not a PSF or raw VGA bitmap font")
+	ErrFontName  = errors.New("font name is unknown")
+	ErrGrid      = errors.New("grid data is not a serialized grid or is truncated")
+	ErrRange     = errors.New("row range is invalid")
+	ErrHTML      = errors.New("html tag is not terminated")
+
+	ErrTemplateData = errors.New("template data is not a []byte, string or io.Reader")
 )
 
+// DecodeError is the position in the data of a binary screen dump where decoding failed,
+// so that tools can report exactly where the problem occurred and optionally skip past it.
+// The Err is either an error returned by the Reader or a [LimitError] of [WithLimits],
+// as every pair of bytes decodes to a valid cell.
+type DecodeError struct {
+	Offset int64 // Offset is the byte offset of the cell in the data.
+	Row    int   // Row is the zero-based row of the cell.
+	Col    int   // Col is the zero-based column of the cell.
+	Err    error // Err is the cause of the failure.
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("offset %d (row %d, col %d): %v", e.Offset, e.Row, e.Col, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// decodeError returns the error with the position of the cell at the byte offset.
+func (d *Decoder) decodeError(offset int, err error) *DecodeError {
+	const pair = 2
+	cell := offset / pair
+	return &DecodeError{
+		Offset: int64(offset),
+		Row:    cell / d.columns,
+		Col:    cell % d.columns,
+		Err:    err,
+	}
+}
+
 // Palette sets the 4-bit (0-15) color codes to a colorset of RGB values.
 type Palette uint
 
@@ -30,6 +81,16 @@ const (
 	// RevisedCGA is the Revised Color Graphics colorset as documented by VilaR,
 	// https://int10h.org/blog/2022/06/ibm-5153-color-true-cga-palette/
 	RevisedCGA
+	// Tandy is the colorset of the Tandy 1000 series and the IBM PCjr video circuitry,
+	// whose monitors display color 6 as a dark yellow instead of the brown of the IBM 5153.
+	Tandy
+	// Composite is an approximation of the colorset of a CGA card connected to a
+	// composite color monitor or television, with its darker and hue shifted colors.
+	Composite
+	// MDA is the IBM Monochrome Display Adapter, also emulated by the Hercules Graphics Card,
+	// where the attribute sets the underline, intensity, reverse video and invisible
+	// text modes rather than colors.
+	MDA
 )
 
 // Color code represented as a hexadecimal triplet or six-digit value.
@@ -67,6 +128,23 @@ const (
 	RedIR     Color = "dc4e4e" // 12 intense red
 	MagentaIR Color = "f34ef3" // 13 intense magenta
 	YellowR   Color = "f3f34e" // 14 intense brown (yellow)
+
+	BrownT Color = "aa0" // 06 dark yellow
+
+	BlueC     Color = "123195" // 01 blue
+	GreenC    Color = "308208" // 02 green
+	CyanC     Color = "3b9d71" // 03 cyan
+	RedC      Color = "872550" // 04 red
+	MagentaC  Color = "9645bf" // 05 magenta
+	BrownC    Color = "ca7865" // 06 brown
+	GrayC     Color = "adadad" // 07 gray
+	BlackIC   Color = "545454" // 08 intense black
+	BlueIC    Color = "6d83cc" // 09 intense blue
+	GreenIC   Color = "93ce76" // 10 intense green
+	CyanIC    Color = "a3eacb" // 11 intense cyan
+	RedIC     Color = "c7809f" // 12 intense red
+	MagentaIC Color = "dfa3fc" // 13 intense magenta
+	YellowC   Color = "fde79e" // 14 intense brown (yellow)
 )
 
 // BG returns the CSS background-color property and color value.
@@ -85,6 +163,79 @@ func (c Color) FG() string {
 	return "color:#" + string(c) + ";"
 }
 
+// RGB returns the red, green and blue values of the color.
+// A three digit hexadecimal triplet is expanded, so "a50" returns 0xaa, 0x55, 0x00.
+// An invalid color returns black.
+func (c Color) RGB() (uint8, uint8, uint8) {
+	const triplet, sixDigit = 3, 6
+	s := string(c)
+	switch len(s) {
+	case triplet:
+		s = string([]byte{s[0], s[0], s[1], s[1], s[2], s[2]})
+	case sixDigit:
+	default:
+		return 0, 0, 0
+	}
+	n, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return 0, 0, 0
+	}
+	return uint8(n >> 16), uint8(n >> 8), uint8(n) //nolint:gosec,mnd
+}
+
+// rgbColor returns the red, green and blue values as a six-digit Color.
+func rgbColor(r, g, b uint8) Color {
+	return Color(fmt.Sprintf("%02x%02x%02x", r, g, b))
+}
+
+// hex returns the color as a six-digit hexadecimal value.
+func (c Color) hex() string {
+	r, g, b := c.RGB()
+	return fmt.Sprintf("%02x%02x%02x", r, g, b)
+}
+
+// RGBA implements the [color.Color] interface, so the colors can be used with the image packages.
+// An invalid color returns opaque black.
+func (c Color) RGBA() (uint32, uint32, uint32, uint32) {
+	r, g, b := c.RGB()
+	return color.RGBA{R: r, G: g, B: b, A: 0xff}.RGBA()
+}
+
+// Valid reports whether the color is a three or six digit hexadecimal triplet without a # prefix.
+func (c Color) Valid() bool {
+	const triplet, sixDigit = 3, 6
+	if len(c) != triplet && len(c) != sixDigit {
+		return false
+	}
+	for _, r := range c {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", r) {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseColor returns the color of a three or six digit hexadecimal triplet,
+// with an optional # prefix, such as "#ff5500" or "f50".
+// A malformed value returns [ErrColor].
+func ParseColor(s string) (Color, error) {
+	c := Color(strings.ToLower(strings.TrimPrefix(s, "#")))
+	if !c.Valid() {
+		return "", fmt.Errorf("%w: %q", ErrColor, s)
+	}
+	return c, nil
+}
+
+// Validate returns [ErrColor] for the first color of the colorset that is not [Color.Valid].
+func (c Colors) Validate() error {
+	for i, col := range c {
+		if !col.Valid() {
+			return fmt.Errorf("color %d: %w: %q", i, ErrColor, col)
+		}
+	}
+	return nil
+}
+
 type Colors [16]Color
 
 func CGA() Colors {
@@ -101,29 +252,118 @@ func CGARevised() Colors {
 	}
 }
 
-// Decoder maintains the screen buffer and print character state.
+// TandyPCjr returns the colorset of the Tandy 1000 and IBM PCjr,
+// which unlike the IBM 5153 monitor, do not adjust the dark yellow color to brown.
+func TandyPCjr() Colors {
+	return Colors{
+		Black, Blue, Green, Cyan, Red, Magenta, BrownT, Gray,
+		BlackI, BlueI, GreenI, CyanI, RedI, MagentaI, Yellow, White,
+	}
+}
+
+// CGAComposite returns an approximation of the colorset of a new-style CGA card
+// displayed on a composite monitor. The colors are derived from a YIQ model of
+// the NTSC signal, with the luminance levels of the composite output and the
+// reduced saturation and shifted hue of the chroma, rather than measured hardware.
+func CGAComposite() Colors {
+	return Colors{
+		Black, BlueC, GreenC, CyanC, RedC, MagentaC, BrownC, GrayC,
+		BlackIC, BlueIC, GreenIC, CyanIC, RedIC, MagentaIC, YellowC, White,
+	}
+}
+
+// Decoder maintains the screen grid and print character state.
 type Decoder struct {
-	Debug       bool // Debug will wrap every character in its own <span> element with a data-xy attribute.
-	charset     *charmap.Charmap
-	colors      Colors
-	columns     int // maximum
-	column      int
-	row         int
-	maxRows     int
-	buffer      []template.HTML
-	currentLine template.HTML
-	currentAttr byte
+	Debug    bool // Debug will wrap every character in its own <span> element with a data-xy attribute.
+	Optimize bool // Optimize will merge <span> elements across rows and blank characters to shrink the HTML.
+	ASCII    bool // ASCII will write the non-ASCII characters as numeric character references, such as &#x2588;.
+	Minify   bool // Minify will remove the row newlines and the spans of the most common attribute.
+	Links    bool // Links will wrap the URLs, FTP and telnet addresses of the text in <a> elements.
+	// Trace will wrap every character in its own <span> element with a data-offset attribute,
+	// which is the byte offset of the character and attribute pair in the binary dump.
+	Trace bool
+	// Indent, when not empty, writes each row on its own line indented by the string, with
+	// the following span elements of the row on further indented lines. As this adds
+	// white-space to the text, it is intended for inspecting and diffing the markup,
+	// such as in golden tests and code review, rather than for display.
+	Indent string
+	// Separator is the markup that separates the rows, which by default is a newline.
+	Separator RowSeparator
+	// RowID is the prefix of the id attribute of each row element, where the suffix is the
+	// row number, such as "r" for id="r12". It is only used by the RowDiv separator.
+	RowID string
+	// RowClass is the class attribute of each row element, where any %d is replaced by the
+	// row number. It is only used by the RowDiv separator, and by default is "row".
+	RowClass string
+	// ClassPrefix, when not empty, replaces the inline styles with generated classes that
+	// are written once in a <style> element, such as .bb0{color:#aaa;background-color:#000;}
+	// for a "bb" prefix. A prefix that is not a valid CSS identifier is replaced by "bb".
+	ClassPrefix string
+	// LetterSpacing, LineHeight and FontFamily, when not empty, are the CSS values of the
+	// letter-spacing, line-height and font-family properties written in the style of the
+	// outer div element, such as "1px", "1" and "'IBM VGA', monospace", so the art aligns
+	// with the web font of the viewer. Characters that are not used by such values are removed.
+	LetterSpacing string
+	LineHeight    string
+	FontFamily    string
+	// Space, when not 0, replaces the space characters, so the alignment of the art survives
+	// where the white-space:pre style cannot be applied, such as in sanitized CMS fields.
+	// The non-breaking space U+00A0 is written as &nbsp;.
+	Space rune
+	// Background, when not empty, is the background color of the page, so the
+	// background-color declarations of the cells that match it are omitted.
+	// It is also written in the style of the outer element.
+	Background Color
+	// Element is the tag name of the outer element, which is a div element when empty or
+	// not a valid tag name. ElementID and ElementClass are the id and class attributes
+	// of the outer element, and ElementAttrs are any other attributes, written in name order,
+	// so the output can slot directly into the structure of an existing page.
+	Element      string
+	ElementID    string
+	ElementClass []string
+	ElementAttrs map[string]string
+	// ChunkRows, when greater than 0, wraps each group of ChunkRows rows in a div element
+	// with the content-visibility:auto style, so that browsers skip the layout and painting
+	// of the rows outside of the viewport, keeping the initial load of long scrollers fast.
+	ChunkRows int
+	// Workers is the number of goroutines that concurrently render the rows of large grids,
+	// which is ignored when Optimize is true. A value of 0 or 1 renders the rows sequentially.
+	Workers int
+	grid    *Grid
+	stats   Stats
+	columns int // maximum
+	column  int
+	row     int
+	maxRows int
+	line    []Cell
+	read    int // number of bytes read
+	// progress is called after each row is read.
+	progress func(rowsDone, bytesRead int)
+	// rowFunc, when not nil, is called after each row is read, with the row number.
+	rowFunc    func(y int, row []Cell) error
+	logger     *slog.Logger
+	trim       bool
+	policy     ErrorPolicy // policy is the handling of the errors returned by the Reader
+	cache      Cache
+	record     *sauce.Record // record is the SAUCE metadata of the data
+	metadata   Metadata
+	responsive bool
+	stripBlink bool
+	reverse    bool
+	limits     limits
 }
 
 // NewDecoder creates a Decoder with a given width (columns). If width <= 0, 160 is used.
 // maxRows should usually be left at 0, its use is only intended for screen dumps that
 // contain tailing NULL or corrupt SAUCE metadata that should be ignored.
 //
-// Palette can either be [StandardCGA] or [RevisedCGA].
+// Palette can either be [StandardCGA], [RevisedCGA], [Tandy], [Composite] or [MDA].
 //
 // Generally the charset of a binary screen dump is [charmap.CodePage437],
 // which is used by default when a nil value is used.
-func NewDecoder(width, maxRows int, pal Palette, charset *charmap.Charmap) *Decoder {
+//
+// Any options are applied to the Decoder in order.
+func NewDecoder(width, maxRows int, pal Palette, charset *charmap.Charmap, opts ...Option) *Decoder {
 	if width <= 0 {
 		width = 160
 	}
@@ -131,7 +371,11 @@ func NewDecoder(width, maxRows int, pal Palette, charset *charmap.Charmap) *Deco
 		charset = charmap.CodePage437
 	}
 	d := &Decoder{
-		charset: charset,
+		logger: slog.New(slog.DiscardHandler),
+		grid: &Grid{
+			charset: charset,
+			width:   width,
+		},
 		columns: width,
 		column:  1,
 		row:     1,
@@ -140,32 +384,45 @@ func NewDecoder(width, maxRows int, pal Palette, charset *charmap.Charmap) *Deco
 	if maxRows > 0 {
 		d.maxRows = maxRows
 	}
-	switch pal {
-	case StandardCGA:
-		d.colors = CGA()
-	case RevisedCGA:
-		d.colors = CGARevised()
-	default:
-		d.colors = CGA()
+	d.grid.setPalette(pal)
+	for _, opt := range opts {
+		opt(d)
 	}
 	return d
 }
 
+// Grid returns the screen grid of the cells decoded by [Decoder.Read].
+func (d *Decoder) Grid() *Grid {
+	return d.grid
+}
+
 // Buffer creates a new Buffer containing the HTML elements of the binary dump
 // found in the Reader.
 //
 // The other arguments are used by the [NewDecoder] which documents their purpose.
-func Buffer(r io.Reader, width, maxRows int, pal Palette, charset *charmap.Charmap) (*bytes.Buffer, error) {
+func Buffer(r io.Reader, width, maxRows int, pal Palette, charset *charmap.Charmap, opts ...Option,
+) (*bytes.Buffer, error) {
+	return BufferContext(context.Background(), r, width, maxRows, pal, charset, opts...)
+}
+
+// BufferContext is the same as [Buffer], but the conversion is stopped and the
+// context error is returned when ctx is canceled or its deadline expires.
+func BufferContext(ctx context.Context, r io.Reader, width, maxRows int, pal Palette, charset *charmap.Charmap,
+	opts ...Option,
+) (*bytes.Buffer, error) {
 	if r == nil {
 		return nil, ErrReader
 	}
 	if charset == nil {
 		charset = charmap.CodePage437
 	}
-	d := NewDecoder(width, maxRows, pal, charset)
-	if err := d.Read(r); err != nil {
+	d := NewDecoder(width, maxRows, pal, charset, opts...)
+	if err := d.ReadContext(ctx, r); err != nil {
 		return nil, err
 	}
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("buffer: %w", err)
+	}
 	var b bytes.Buffer
 	out := bufio.NewWriter(&b)
 	if err := d.Write(out); err != nil {
@@ -214,42 +471,180 @@ func WriteTo(r io.Reader, w io.Writer) (int64, error) {
 	return i, nil
 }
 
-// Write writes to w the full HTML fragment with outer div and inner lines joined with newlines.
+// Write writes to w the full HTML fragment with outer div and inner lines joined with newlines,
+// or the markup of the Separator.
+//
+// If Debug or Trace is true, Optimize is ignored.
 func (d *Decoder) Write(wr io.Writer) error {
 	if wr == nil {
 		wr = io.Discard
 	}
-	t, err := template.New("dump").Parse(
-		`{{define "T"}}<div>{{ . }}</div>{{end}}`)
-	if err != nil {
-		return fmt.Errorf("write template parse: %w", err)
+	var key string
+	if d.cache != nil {
+		key = d.cacheKey()
+		if html, ok := d.cache.Get(key); ok {
+			n, err := wr.Write(html)
+			d.stats = Stats{Bytes: int64(n)}
+			if err != nil {
+				return fmt.Errorf("write cache: %w", err)
+			}
+			return nil
+		}
+		var b bytes.Buffer
+		if err := d.write(&b); err != nil {
+			return err
+		}
+		d.cache.Set(key, b.Bytes())
+		if _, err := b.WriteTo(wr); err != nil {
+			return fmt.Errorf("write cache: %w", err)
+		}
+		return nil
 	}
-	var data template.HTML
-	for s := range slices.Values(d.buffer) {
-		data += s
+	return d.write(wr)
+}
+
+// write writes to wr the HTML fragment of the grid.
+func (d *Decoder) write(wr io.Writer) error {
+	cw := &countWriter{w: wr}
+	hw := d.newHTMLWriter(cw)
+	defer hw.release()
+	if d.Minify {
+		d.writeMinified(hw)
+	} else {
+		d.openElement(hw, d.elementStyle())
+	}
+	if d.ClassPrefix != "" {
+		d.writeClasses(hw)
+	}
+	switch {
+	case d.Optimize && !d.Debug && !d.Trace:
+		d.writeOptimized(hw)
+	case d.Workers > 1:
+		d.writeParallel(hw)
+	default:
+		for i, row := range slices.All(d.grid.rows) {
+			d.writeRow(hw, i+1, row)
+		}
 	}
-	if err := t.ExecuteTemplate(wr, "T", data); err != nil {
-		return fmt.Errorf("write template execute: %w", err)
+	hw.indentLine(0)
+	hw.WriteString("</" + d.element() + ">")
+	if err := hw.Flush(); err != nil {
+		return fmt.Errorf("write flush: %w", err)
 	}
+	d.stats = hw.stats
+	d.stats.Bytes = cw.n
 	return nil
 }
 
-// Read reads each pair of bytes from r and interprets the color sequences, updating the buffer.
+// HTML returns the HTML fragment of the grid for use in [html/template] contexts,
+// without the need to round-trip through a Writer.
+//
+//nolint:gosec
+func (d *Decoder) HTML() template.HTML {
+	return template.HTML(d.String())
+}
+
+// String returns the HTML fragment of the grid.
+func (d *Decoder) String() string {
+	var sb strings.Builder
+	// a strings.Builder never returns a write error
+	_ = d.Write(&sb)
+	return sb.String()
+}
+
+// Stats returns the size statistics of the HTML fragment created by the last [Decoder.Write].
+func (d *Decoder) Stats() Stats {
+	return d.stats
+}
+
+// RowsDecoded returns the number of rows of the grid decoded by [Decoder.Read],
+// including a short final row of a partial dump.
+func (d *Decoder) RowsDecoded() int {
+	return len(d.grid.rows)
+}
+
+// BytesRead returns the number of bytes read by [Decoder.Read], which can be compared
+// with the size of the input to detect a short read.
+func (d *Decoder) BytesRead() int64 {
+	return int64(d.read)
+}
+
+// SpansEmitted returns the number of span elements written by the last [Decoder.Write],
+// which is the same as the Spans of [Decoder.Stats].
+func (d *Decoder) SpansEmitted() int {
+	return d.stats.Spans
+}
+
+// Reset clears the grid, the read state and the statistics, so the configured Decoder
+// can be reused to read another screen dump. The memory allocated for the rows
+// of the grid is reused, so any [Grid] returned by [Decoder.Grid] before the
+// Reset must no longer be used.
+func (d *Decoder) Reset() {
+	d.grid.rows = d.grid.rows[:0]
+	d.line = nil
+	d.column = 1
+	d.row = 1
+	d.read = 0
+	d.stats = Stats{}
+	d.record = nil
+}
+
+// Read reads each pair of bytes from r and interprets the color sequences, updating the grid.
+// Any error returned by r, other than io.EOF, is wrapped in a [DecodeError] and returned,
+// leaving the grid with the rows decoded before the error.
 func (d *Decoder) Read(r io.Reader) error {
+	return d.ReadContext(context.Background(), r)
+}
+
+// ReadContext is the same as [Decoder.Read], but ctx is periodically checked so that
+// servers converting untrusted uploads can enforce deadlines and cancel runaway conversions.
+// When ctx is done, the reading stops and the context error is returned,
+// leaving the grid with the rows decoded so far.
+//
+// A Reader that blocks is not interrupted, as the ctx is only checked between reads.
+func (d *Decoder) ReadContext(ctx context.Context, r io.Reader) error {
+	if err := d.limitColumns(); err != nil {
+		return err
+	}
+	r = d.limitReader(r)
+	if d.trim {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			err = fmt.Errorf("decoder read all: %w", err)
+			if le := new(LimitError); errors.As(err, &le) {
+				return d.decodeError(len(data), err)
+			}
+			return err
+		}
+		if rec, err := sauce.Decode(data); err == nil {
+			d.record = &rec
+		}
+		r = bytes.NewReader(TrimMetadata(data))
+	}
 	scanner := bufio.NewScanner(r)
-	const maxBuf = 64 * 1024
-	buf := make([]byte, maxBuf)
-	scanner.Buffer(buf, maxBuf)
+	buf := scanBuffers.Get().(*[]byte) //nolint:forcetypeassert
+	defer scanBuffers.Put(buf)
+	scanner.Buffer(*buf, len(*buf))
 	scanner.Split(splitTwoBytes)
-	for scanner.Scan() {
+	const checkInterval = 4096 // number of cells read between context checks
+	for i := 0; scanner.Scan(); i++ {
+		if i%checkInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return fmt.Errorf("decoder read: %w", err)
+			}
+		}
 		tok := scanner.Bytes()
+		if err := d.limitRows(); err != nil {
+			return d.decodeError(d.read, err)
+		}
+		d.read += len(tok)
 		chr := tok[0]
 		atr := tok[1]
-		if err := d.writeChar(chr, atr); err != nil {
-			return err
-		}
+		d.readCell(chr, atr)
 		if d.endOfRow() {
-			d.writeRow()
+			if err := d.readRow(); err != nil {
+				return err
+			}
 			continue
 		}
 		d.column++
@@ -259,11 +654,17 @@ func (d *Decoder) Read(r io.Reader) error {
 	}
 	// edge case, for handling tests or partially corrupted data dumps
 	if d.maxRows == 0 && d.column != 1 {
-		d.writeRow()
+		if err := d.readRow(); err != nil {
+			return err
+		}
 	}
 	if err := scanner.Err(); err != nil {
-		fmt.Fprintln(os.Stderr, "scan error:", err)
+		d.logger.ErrorContext(ctx, "binbump decoder scan", "err", err, "bytes", d.read)
+		if le := new(LimitError); errors.As(err, &le) || d.policy == Strict {
+			return d.decodeError(d.read, fmt.Errorf("decoder scan: %w", err))
+		}
 	}
+	d.logger.DebugContext(ctx, "binbump decoder read", "rows", len(d.grid.rows), "bytes", d.read)
 	return nil
 }
 
@@ -300,54 +701,227 @@ func (d *Decoder) endOfRow() bool {
 	return n > 0 && n%d.columns == 0
 }
 
-//nolint:gosec
-func (d *Decoder) writeChar(b, atr byte) error {
-	const msg = "data is not a video binary dump"
-	fg, bg := decodeAttr(atr)
-	const lastColor = 15
-	if fg > lastColor {
-		return fmt.Errorf("%s %X foreground color, %d > 15: %w", msg, bg, bg, ErrAttribute)
-	}
-	if bg > lastColor {
-		return fmt.Errorf("%s %X background color, %d > 15: %w", msg, bg, bg, ErrAttribute)
-	}
-	chr := html.EscapeString(string(d.charset.DecodeByte(b)))
-	fgc := d.colors[fg].FG()
-	bgc := d.colors[bg].BG()
-	if d.Debug {
-		// debug wraps every character within its own span element
-		d.currentLine += template.HTML(`<span data-xy="` +
-			fmt.Sprintf("%dx%d", d.row, d.column) +
-			`" style="` + fgc + bgc + `">` + chr + `</span>`)
-		return nil
+func (d *Decoder) readCell(b, atr byte) {
+	if d.stripBlink {
+		const blink = 0x80
+		atr &^= blink
+	}
+	if d.reverse {
+		atr = reverse(atr)
+	}
+	if d.line == nil {
+		// reuse the cells of a row discarded by Reset
+		if rows := d.grid.rows; len(rows) < cap(rows) {
+			d.line = rows[:len(rows)+1][len(rows)][:0]
+		}
 	}
-	// if the color attributes are identical to the colors used by the
-	// previous character, then the character will be appended to the
-	// span text content.
-	// this should significantly reduce the size and node numbers of the
-	// final HTML snippet
-	if sameColors := d.column > 1 && d.currentAttr == atr; sameColors {
-		d.currentLine += template.HTML(chr)
-		return nil
+	if d.line == nil {
+		d.line = make([]Cell, 0, d.columns)
 	}
-	if newline := d.column <= 1; newline {
-		d.currentLine += template.HTML(`<span style="` + fgc + bgc + `">` + chr)
-		d.currentAttr = atr
-		return nil
+	d.line = append(d.line, Cell{Char: b, Attr: atr})
+}
+
+func (d *Decoder) readRow() error {
+	y := d.row - 1
+	d.grid.rows = append(d.grid.rows, d.line)
+	d.line = nil
+	d.row++
+	d.column = 1
+	if d.progress != nil {
+		d.progress(y+1, d.read)
+	}
+	if d.rowFunc != nil {
+		return d.rowFunc(y, d.grid.rows[len(d.grid.rows)-1])
 	}
-	// if colors have changed, we close the previous span element
-	// and create a new element with the new color attributes.
-	d.currentLine += template.HTML(`</span><span style="` + fgc + bgc + `">` + chr)
-	d.currentAttr = atr
 	return nil
 }
 
-func (d *Decoder) writeRow() {
-	if !d.Debug {
-		d.currentLine += `</span>`
+// writeRow writes the HTML elements of the row of cells, where y is the row number.
+func (d *Decoder) writeRow(w *htmlWriter, y int, row []Cell) {
+	var (
+		currentAttr byte
+		run         bool // run is true while the cells continue the text of currentAttr
+		open        bool // open is true while a span element is open
+		links       []link
+	)
+	if d.Links {
+		links = d.grid.links(row)
+	}
+	closeSpan := func() {
+		if open {
+			w.WriteString(`</span>`)
+			open = false
+		}
+		run = false
+	}
+	w.rowStart(y)
+	for i, c := range row {
+		x := i + 1
+		w.stats.Cells++
+		if len(links) > 0 && links[0].start == i {
+			closeSpan()
+			w.anchor(links[0].href)
+		}
+		switch {
+		case d.Debug || d.Trace:
+			// debug and trace wrap every character within its own span element
+			w.stats.Spans++
+			w.spanLine()
+			w.WriteString(`<span`)
+			if d.Debug {
+				w.WriteString(` data-xy="`)
+				w.num = strconv.AppendInt(w.num[:0], int64(y), 10)
+				w.num = append(w.num, 'x')
+				w.num = strconv.AppendInt(w.num, int64(x), 10)
+				w.Write(w.num)
+				w.WriteByte('"')
+			}
+			if d.Trace {
+				const pair = 2
+				offset := ((y-1)*d.grid.width + i) * pair
+				w.WriteString(` data-offset="`)
+				w.num = strconv.AppendInt(w.num[:0], int64(offset), 10)
+				w.Write(w.num)
+				w.WriteByte('"')
+			}
+			if style := w.styles[c.Attr]; !w.bare(style) {
+				w.WriteString(w.attr)
+				w.WriteString(style)
+				w.WriteByte('"')
+			}
+			w.WriteByte('>')
+			w.char(d.grid.rune(c))
+			w.WriteString(`</span>`)
+		case run && currentAttr == c.Attr:
+			// if the color attributes are identical to the colors used by the
+			// previous character, then the character will be appended to the
+			// span text content.
+			// this should significantly reduce the size and node numbers of the
+			// final HTML snippet
+			w.char(d.grid.rune(c))
+		default:
+			// if colors have changed, we close the previous span element
+			// and create a new element with the new color attributes.
+			closeSpan()
+			if style := w.styles[c.Attr]; !w.bare(style) {
+				w.stats.Spans++
+				w.span(style)
+				open = true
+			}
+			w.char(d.grid.rune(c))
+			currentAttr, run = c.Attr, true
+		}
+		if len(links) > 0 && links[0].end == x {
+			closeSpan()
+			w.WriteString(`</a>`)
+			links = links[1:]
+		}
+	}
+	closeSpan()
+	w.rowEnd(y)
+}
+
+// htmlWriter buffers the HTML elements of a grid, using cached style declarations for
+// each attribute to avoid allocating strings for every character.
+type htmlWriter struct {
+	*bufio.Writer
+	styles   [256]string  // styles are the color declarations of each attribute
+	bgStyles [16]string   // bgStyles are the background-color declarations of each color code
+	num      []byte       // num is a scratch buffer for formatting numbers
+	stats    Stats        // stats are the number of cells and span elements written
+	ascii    bool         // ascii writes the non-ASCII characters as numeric character references
+	space    rune         // space replaces the space characters when not 0
+	sep      RowSeparator // sep is the markup that separates the rows
+	rowID    string       // rowID is the id prefix of the row elements
+	rowClass string       // rowClass is the class pattern of the row elements
+	attr     string       // attr is the attribute name and opening quote of the styles
+	indent   string       // indent is the indentation of each line in the Indent mode
+	rowSpans int          // rowSpans is the number of span elements written in the row
+	chunk    int          // chunk is the number of rows of each content-visibility container
+	rows     int          // rows is the number of rows of the grid
+}
+
+// newHTMLWriter returns a htmlWriter for w using the colors of the grid.
+// The htmlWriter should be released after use.
+func (d *Decoder) newHTMLWriter(w io.Writer) *htmlWriter {
+	bw := htmlBuffers.Get().(*bufio.Writer) //nolint:forcetypeassert
+	bw.Reset(w)
+	hw := &htmlWriter{
+		Writer:   bw,
+		ascii:    d.ASCII,
+		space:    d.Space,
+		sep:      d.Separator,
+		rowID:    d.RowID,
+		rowClass: d.RowClass,
+		attr:     ` style="`,
+		indent:   d.Indent,
+		chunk:    d.ChunkRows,
+		rows:     len(d.grid.rows),
+	}
+	for i, c := range d.grid.colors {
+		if !d.background(c) {
+			hw.bgStyles[i] = c.BG()
+		}
+	}
+	for i := range hw.styles {
+		c := Cell{Attr: byte(i)}
+		fg, bg := d.grid.attrColors(c)
+		hw.styles[i] = d.grid.colors[fg].FG() + hw.bgStyles[bg]
+		if d.grid.underline(c) {
+			hw.styles[i] += "text-decoration:underline;"
+		}
+	}
+	return hw
+}
+
+// span writes the opening tag of a span element with the style or class attribute.
+func (w *htmlWriter) span(style string) {
+	w.spanLine()
+	w.WriteString(`<span`)
+	w.WriteString(w.attr)
+	w.WriteString(style)
+	w.WriteString(`">`)
+}
+
+// char writes the character, escaping the same characters as [html.EscapeString],
+// the line feed and carriage return, and the non-ASCII characters when ascii is true.
+func (w *htmlWriter) char(r rune) {
+	switch r {
+	case '<':
+		w.WriteString("&lt;")
+	case '>':
+		w.WriteString("&gt;")
+	case '&':
+		w.WriteString("&amp;")
+	case '\'':
+		w.WriteString("&#39;")
+	case '"':
+		w.WriteString("&#34;")
+	case '\n':
+		// the line feed and carriage return characters are written as references,
+		// so they are not mistaken for the newlines that separate the rows
+		w.WriteString("&#xa;")
+	case '\r':
+		w.WriteString("&#xd;")
+	case ' ':
+		const nbsp = '\u00a0'
+		switch w.space {
+		case 0, ' ':
+			w.WriteByte(' ')
+		case nbsp:
+			w.WriteString("&nbsp;")
+		default:
+			w.char(w.space)
+		}
+	default:
+		const hex, lastASCII = 16, 0x7f
+		if w.ascii && r > lastASCII {
+			w.WriteString("&#x")
+			w.num = strconv.AppendInt(w.num[:0], int64(r), hex)
+			w.Write(w.num)
+			w.WriteByte(';')
+			return
+		}
+		w.WriteRune(r)
 	}
-	d.buffer = append(d.buffer, d.currentLine+"\n")
-	d.currentLine = ""
-	d.row++
-	d.column = 1
 }
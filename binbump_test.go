@@ -3,7 +3,15 @@ package binbump_test
 import (
 	"bufio"
 	"bytes"
+	"errors"
 	"fmt"
+	"html/template"
+	"image/color"
+	"io"
+	"os"
+	"runtime"
+	"testing"
+	"testing/iotest"
 
 	"github.com/bengarrett/binbump"
 	"golang.org/x/text/encoding/charmap"
@@ -33,6 +41,31 @@ func ExampleBuffer_palette() {
 	// Output: "<div><span style=\"color:#000;background-color:#000;\">A</span><span style=\"color:#4e4e4e;background-color:#000;\">B</span>\n</div>"
 }
 
+func ExampleBuffer_tandy() {
+	data := []byte{0x41, 0x06, 0x42, 0x0e}
+	r := bytes.NewReader(data)
+	buf, _ := binbump.Buffer(r, 80, 0, binbump.Tandy, nil)
+	fmt.Printf("%q", buf.String())
+	// Output: "<div><span style=\"color:#aa0;background-color:#000;\">A</span><span style=\"color:#ff5;background-color:#000;\">B</span>\n</div>"
+}
+
+func ExampleBuffer_composite() {
+	data := []byte{0x41, 0x1e}
+	r := bytes.NewReader(data)
+	buf, _ := binbump.Buffer(r, 80, 0, binbump.Composite, nil)
+	fmt.Printf("%q", buf.String())
+	// Output: "<div><span style=\"color:#fde79e;background-color:#123195;\">A</span>\n</div>"
+}
+
+func ExampleBuffer_mda() {
+	// normal, underline, bright, reverse video and invisible attributes
+	data := []byte{0x41, 0x07, 0x42, 0x01, 0x43, 0x0f, 0x44, 0x70, 0x45, 0x00}
+	r := bytes.NewReader(data)
+	buf, _ := binbump.Buffer(r, 80, 0, binbump.MDA, nil)
+	fmt.Printf("%q", buf.String())
+	// Output: "<div><span style=\"color:#aaa;background-color:#000;\">A</span><span style=\"color:#aaa;background-color:#000;text-decoration:underline;\">B</span><span style=\"color:#fff;background-color:#000;\">C</span><span style=\"color:#000;background-color:#aaa;\">D</span><span style=\"color:#000;background-color:#000;\">E</span>\n</div>"
+}
+
 func ExampleBytes() {
 	data := []byte{0x41, 0x00, 0x42, 0x08}
 	r := bytes.NewReader(data)
@@ -61,28 +94,227 @@ func ExampleWriteTo() {
 	// "<div><span style=\"color:#000;background-color:#000;\">A</span><span style=\"color:#555;background-color:#000;\">B</span>\n</div>"
 }
 
-// func TestBuffer_Open(t *testing.T) {
-// 	t.Parallel()
-// 	file, err := os.Open("testdata/file.bin")
-// 	if err != nil {
-// 		panic(err)
-// 	}
-// 	defer file.Close()
-//
-// 	buf, err := binbump.Buffer(
-// 		file, 80, 25, binbump.StandardCGA, nil)
-// 	if err != nil {
-// 		panic(err)
-// 	}
-//
-// 	f, err := os.OpenFile("test.html", os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0o600)
-// 	if err != nil {
-// 		panic(err)
-// 	}
-// 	defer f.Close()
-// 	n, err := io.Copy(f, buf)
-// 	if err != nil {
-// 		panic(err)
-// 	}
-// 	fmt.Println("wrote", n, "bytes to test.html")
-// }
+func ExampleParseColor() {
+	c, err := binbump.ParseColor("#FF5500")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(c, c.FG())
+	_, err = binbump.ParseColor("#ff550")
+	fmt.Println(err)
+	// Output: ff5500 color:#ff5500;
+	// color is not a 3 or 6 digit hexadecimal triplet: "#ff550"
+}
+
+func ExampleColor_RGBA() {
+	var c color.Color = binbump.Brown
+	gray := color.GrayModel.Convert(c).(color.Gray)
+	r, g, b, a := c.RGBA()
+	fmt.Printf("%04x %04x %04x %04x %d\n", r, g, b, a, gray.Y)
+	// Output: aaaa 5555 0000 ffff 101
+}
+
+func ExampleColors_Validate() {
+	c := binbump.CGA()
+	c[6] = "brown"
+	fmt.Println(c.Validate())
+	// Output: color 6: color is not a 3 or 6 digit hexadecimal triplet: "brown"
+}
+
+func ExampleDecodeError() {
+	data := bytes.NewReader(bytes.Repeat([]byte{0x41, 0x07}, 83))
+	r := io.MultiReader(data, iotest.ErrReader(io.ErrUnexpectedEOF))
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil)
+	err := d.Read(r)
+	var de *binbump.DecodeError
+	if errors.As(err, &de) {
+		fmt.Println(de.Offset, de.Row, de.Col, errors.Is(err, io.ErrUnexpectedEOF))
+	}
+	// Output: 166 1 3 true
+}
+
+func ExampleDecoder_HTML() {
+	data := []byte{0x41, 0x00, 0x42, 0x08}
+	d := binbump.NewDecoder(0, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	t := template.Must(template.New("page").Parse(`<pre>{{ . }}</pre>`))
+	if err := t.Execute(os.Stdout, d.HTML()); err != nil {
+		panic(err)
+	}
+	// Output: <pre><div><span style="color:#000;background-color:#000;">A</span><span style="color:#555;background-color:#000;">B</span>
+	// </div></pre>
+}
+
+func ExampleDecoder_String() {
+	data := []byte{0x41, 0x00, 0x42, 0x08}
+	d := binbump.NewDecoder(0, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	fmt.Printf("%q", d)
+	// Output: "<div><span style=\"color:#000;background-color:#000;\">A</span><span style=\"color:#555;background-color:#000;\">B</span>\n</div>"
+}
+
+func ExampleDecoder_Write_ascii() {
+	data := []byte{0xdb, 0x04, 0x41, 0x04}
+	d := binbump.NewDecoder(0, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	d.ASCII = true
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: <div><span style="color:#a00;background-color:#000;">&#x2588;A</span>
+	// </div>
+}
+
+func ExampleDecoder_Write_trace() {
+	data := []byte{0x41, 0x07, 0x42, 0x07, 0x43, 0x1f, 0x44, 0x07}
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	d.Trace = true
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: <div><span data-offset="0" style="color:#aaa;background-color:#000;">A</span><span data-offset="2" style="color:#aaa;background-color:#000;">B</span>
+	// <span data-offset="4" style="color:#fff;background-color:#00a;">C</span><span data-offset="6" style="color:#aaa;background-color:#000;">D</span>
+	// </div>
+}
+
+func ExampleDecoder_Write_space() {
+	data := []byte{0x41, 0x07, 0x20, 0x07, 0x20, 0x07, 0x42, 0x07}
+	d := binbump.NewDecoder(0, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	d.Space = '\u00a0'
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: <div><span style="color:#aaa;background-color:#000;">A&nbsp;&nbsp;B</span>
+	// </div>
+}
+
+func ExampleDecoder_Write_background() {
+	data := []byte{0x41, 0x07, 0x20, 0x07, 0x42, 0x1e}
+	d := binbump.NewDecoder(0, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	d.Background = binbump.Black
+	d.Optimize = true
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: <div style="background-color:#000;"><span style="color:#aaa;">A </span><span style="color:#ff5;background-color:#00a;">B
+	// </span></div>
+}
+
+func ExampleDecoder_RowsDecoded() {
+	data := []byte{0x41, 0x07, 0x42, 0x07, 0x43, 0x1f}
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	if err := d.Write(io.Discard); err != nil {
+		panic(err)
+	}
+	fmt.Println(d.RowsDecoded(), d.BytesRead(), d.SpansEmitted())
+	// Output: 2 6 2
+}
+
+func ExampleDecoder_Reset() {
+	files := [][]byte{
+		{0x41, 0x00, 0x42, 0x08},
+		{0x43, 0x07},
+	}
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil)
+	for _, data := range files {
+		d.Reset()
+		if err := d.Read(bytes.NewReader(data)); err != nil {
+			panic(err)
+		}
+		fmt.Print(d.Grid().Transcript())
+	}
+	// Output: AB
+	// C
+}
+
+// benchData returns a deterministic 160 columns by 1000 rows screen dump
+// with frequent color changes.
+func benchData() []byte {
+	const width, rows = 160, 1000
+	p := make([]byte, 0, width*rows*2)
+	for i := range width * rows {
+		p = append(p, byte('A'+i%26), byte(i/7%256))
+	}
+	return p
+}
+
+func BenchmarkBuffer(b *testing.B) {
+	p := benchData()
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := binbump.Buffer(bytes.NewReader(p), 160, 0, binbump.StandardCGA, nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBuffer_parallel(b *testing.B) {
+	p := benchData()
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := binbump.Buffer(bytes.NewReader(p), 160, 0, binbump.StandardCGA, nil); err != nil {
+				b.Error(err)
+			}
+		}
+	})
+}
+
+func BenchmarkDecoder_Write(b *testing.B) {
+	d := binbump.NewDecoder(160, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(benchData())); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := d.Write(io.Discard); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecoder_Write_workers(b *testing.B) {
+	d := binbump.NewDecoder(160, 0, binbump.StandardCGA, nil)
+	d.Workers = runtime.NumCPU()
+	if err := d.Read(bytes.NewReader(benchData())); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := d.Write(io.Discard); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecoder_Write_optimize(b *testing.B) {
+	d := binbump.NewDecoder(160, 0, binbump.StandardCGA, nil)
+	d.Optimize = true
+	if err := d.Read(bytes.NewReader(benchData())); err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := d.Write(io.Discard); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+// Package binbumptest provides golden file helpers for the regression tests of projects
+// that render binary screen dumps with the binbump package.
+//
+// The golden files are compared with the rendered output, and are created or replaced
+// when the tests are run with the -binbumptest.update flag:
+//
+//	go test ./... -binbumptest.update
+package binbumptest
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/bengarrett/binbump"
+)
+
+// Update writes the rendered output to the golden files instead of comparing them.
+//
+//nolint:gochecknoglobals
+var Update = flag.Bool("binbumptest.update", false, "update the golden files of the binbumptest helpers")
+
+// Render decodes the binary screen dump file at the named path using [binbump.DecodeFile],
+// and fails the test if it cannot be decoded.
+func Render(tb testing.TB, name string, opts ...binbump.Option) *binbump.Decoder {
+	tb.Helper()
+	d, err := binbump.DecodeFile(name, opts...)
+	if err != nil {
+		tb.Fatalf("binbumptest render: %v", err)
+	}
+	return d
+}
+
+// HTML compares the HTML fragment written by the decoder with the golden file,
+// and reports the first line that differs.
+func HTML(tb testing.TB, d *binbump.Decoder, golden string) {
+	tb.Helper()
+	var got bytes.Buffer
+	if err := d.Write(&got); err != nil {
+		tb.Fatalf("binbumptest html: %v", err)
+	}
+	want, ok := load(tb, golden, got.Bytes())
+	if !ok {
+		return
+	}
+	if diff := lineDiff(want, got.Bytes()); diff != "" {
+		tb.Errorf("binbumptest html: %s differs %s\n%s", golden, diff, hint)
+	}
+}
+
+// PNG compares the image of the decoder grid with the golden PNG file,
+// and reports the number of pixels that differ and the first that differs.
+func PNG(tb testing.TB, d *binbump.Decoder, golden string) {
+	tb.Helper()
+	var got bytes.Buffer
+	if err := d.Grid().WritePNG(&got); err != nil {
+		tb.Fatalf("binbumptest png: %v", err)
+	}
+	want, ok := load(tb, golden, got.Bytes())
+	if !ok {
+		return
+	}
+	img, err := png.Decode(bytes.NewReader(want))
+	if err != nil {
+		tb.Fatalf("binbumptest png: %s: %v", golden, err)
+	}
+	if diff := imageDiff(img, d.Grid().Image()); diff != "" {
+		tb.Errorf("binbumptest png: %s differs %s\n%s", golden, diff, hint)
+	}
+}
+
+// hint is the instruction to update the golden files.
+const hint = "run the tests with -binbumptest.update to update the golden files"
+
+// load returns the content of the golden file, or when [Update] is set, replaces the
+// golden file with the got content and returns false as there is nothing to compare.
+func load(tb testing.TB, golden string, got []byte) ([]byte, bool) {
+	tb.Helper()
+	if *Update {
+		const dirPerm, perm = 0o755, 0o644
+		if err := os.MkdirAll(filepath.Dir(golden), dirPerm); err != nil {
+			tb.Fatalf("binbumptest update: %v", err)
+		}
+		if err := os.WriteFile(golden, got, perm); err != nil {
+			tb.Fatalf("binbumptest update: %v", err)
+		}
+		return nil, false
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		tb.Fatalf("binbumptest golden: %v\n%s", err, hint)
+		return nil, false
+	}
+	return want, true
+}
+
+// lineDiff returns a description of the first line that differs between want and got,
+// or an empty string when they are identical.
+func lineDiff(want, got []byte) string {
+	if bytes.Equal(want, got) {
+		return ""
+	}
+	w, g := bytes.Split(want, []byte("\n")), bytes.Split(got, []byte("\n"))
+	for i := range max(len(w), len(g)) {
+		var wl, gl []byte
+		if i < len(w) {
+			wl = w[i]
+		}
+		if i < len(g) {
+			gl = g[i]
+		}
+		if i >= len(w) || i >= len(g) || !bytes.Equal(wl, gl) {
+			col := 0
+			for col < min(len(wl), len(gl)) && wl[col] == gl[col] {
+				col++
+			}
+			return fmt.Sprintf("at line %d, column %d:\n- want: %q\n+  got: %q",
+				i+1, col+1, excerpt(wl, col), excerpt(gl, col))
+		}
+	}
+	return ""
+}
+
+// excerpt returns the bytes of the line surrounding the zero-based column,
+// as the lines of a HTML fragment can be very long.
+func excerpt(line []byte, col int) []byte {
+	const before, after = 20, 40
+	start, end := max(min(col-before, len(line)), 0), min(col+after, len(line))
+	for start > 0 && !utf8.RuneStart(line[start]) {
+		start--
+	}
+	for end < len(line) && !utf8.RuneStart(line[end]) {
+		end++
+	}
+	return line[start:end]
+}
+
+// imageDiff returns a description of the pixels that differ between want and got,
+// or an empty string when they are identical.
+func imageDiff(want, got image.Image) string {
+	if want.Bounds() != got.Bounds() {
+		return fmt.Sprintf("in size:\n- want: %v\n+  got: %v", want.Bounds().Size(), got.Bounds().Size())
+	}
+	n := 0
+	var first image.Point
+	b := want.Bounds()
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			wr, wg, wb, wa := want.At(x, y).RGBA()
+			gr, gg, gb, ga := got.At(x, y).RGBA()
+			if wr == gr && wg == gg && wb == gb && wa == ga {
+				continue
+			}
+			if n == 0 {
+				first = image.Pt(x, y)
+			}
+			n++
+		}
+	}
+	if n == 0 {
+		return ""
+	}
+	return fmt.Sprintf("in %d of %d pixels, first at %v:\n- want: %v\n+  got: %v",
+		n, b.Dx()*b.Dy(), first, want.At(first.X, first.Y), got.At(first.X, first.Y))
+}
@@ -0,0 +1,68 @@
+package binbumptest_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bengarrett/binbump"
+	"github.com/bengarrett/binbump/binbumptest"
+)
+
+// recorder records the errors of the helpers instead of failing the test.
+type recorder struct {
+	testing.TB
+	errs []string
+}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errs = append(r.errs, fmt.Sprintf(format, args...))
+}
+
+// screen writes a synthetic screen dump to a temporary file and returns its path.
+func screen(t *testing.T, pattern binbump.Pattern) string {
+	t.Helper()
+	name := filepath.Join(t.TempDir(), "screen.bin")
+	data := binbump.Generate(binbump.Spec{Pattern: pattern, Width: 16, Height: 4})
+	if err := os.WriteFile(name, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return name
+}
+
+// update sets the update flag for the duration of fn.
+func update(fn func()) {
+	*binbumptest.Update = true
+	defer func() { *binbumptest.Update = false }()
+	fn()
+}
+
+func TestHTML(t *testing.T) {
+	golden := filepath.Join(t.TempDir(), "testdata", "screen.golden.html")
+	update(func() {
+		binbumptest.HTML(t, binbumptest.Render(t, screen(t, binbump.Gradient)), golden)
+	})
+	binbumptest.HTML(t, binbumptest.Render(t, screen(t, binbump.Gradient)), golden)
+
+	r := &recorder{TB: t}
+	binbumptest.HTML(r, binbumptest.Render(t, screen(t, binbump.ColorChart)), golden)
+	if len(r.errs) != 1 || !strings.Contains(r.errs[0], "differs at line 1, column 28") {
+		t.Errorf("want a line difference, got %q", r.errs)
+	}
+}
+
+func TestPNG(t *testing.T) {
+	golden := filepath.Join(t.TempDir(), "screen.golden.png")
+	update(func() {
+		binbumptest.PNG(t, binbumptest.Render(t, screen(t, binbump.Gradient)), golden)
+	})
+	binbumptest.PNG(t, binbumptest.Render(t, screen(t, binbump.Gradient)), golden)
+
+	r := &recorder{TB: t}
+	binbumptest.PNG(r, binbumptest.Render(t, screen(t, binbump.ColorChart)), golden)
+	if len(r.errs) != 1 || !strings.Contains(r.errs[0], "in 7235 of 20480 pixels") {
+		t.Errorf("want a pixel difference, got %q", r.errs)
+	}
+}
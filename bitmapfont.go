@@ -0,0 +1,185 @@
+package binbump
+
+import (
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Font is a bitmap font of the 256 characters of a charset, such as the font of a VGA
+// text mode, so the screens can be rendered with the glyph shapes intended by the artist.
+type Font struct {
+	Width  int // Width of a glyph in pixels.
+	Height int // Height of a glyph in pixels.
+	// Glyphs are the bitmaps of the characters, as rows of bits with the most significant
+	// bit on the left, where each row is a whole number of bytes.
+	Glyphs [256][]byte
+}
+
+// ParseFont parses the data of a bitmap font in the PC Screen Font format, either PSF1 or
+// PSF2, as used by the Linux console, or a raw VGA font of 256 glyphs 8 pixels wide,
+// such as the .F16 files of DOS font editors or the font block of an XBin file.
+// Only the first 256 glyphs are used.
+func ParseFont(data []byte) (*Font, error) {
+	const (
+		psf1Magic, psf1Header = 0x0436, 4
+		psf2Magic, psf2Header = 0x864ab572, 32
+		glyphs, maxHeight     = 256, 32
+	)
+	switch {
+	case len(data) >= psf1Header && binary.LittleEndian.Uint16(data) == psf1Magic:
+		return newFont(data[psf1Header:], 8, int(data[3]))
+	case len(data) >= psf2Header && binary.LittleEndian.Uint32(data) == psf2Magic:
+		header := int(binary.LittleEndian.Uint32(data[8:]))
+		height := int(binary.LittleEndian.Uint32(data[24:]))
+		width := int(binary.LittleEndian.Uint32(data[28:]))
+		if header < psf2Header || header > len(data) {
+			return nil, fmt.Errorf("%w: psf2 header size %d", ErrFont, header)
+		}
+		return newFont(data[header:], width, height)
+	case len(data) > 0 && len(data)%glyphs == 0 && len(data)/glyphs <= maxHeight:
+		return newFont(data, 8, len(data)/glyphs)
+	}
+	return nil, fmt.Errorf("%w: %d bytes", ErrFont, len(data))
+}
+
+// newFont returns the font of the first 256 glyphs of the data.
+func newFont(data []byte, width, height int) (*Font, error) {
+	const maxSize = 64
+	if width <= 0 || height <= 0 || width > maxSize || height > maxSize {
+		return nil, fmt.Errorf("%w: glyph size %dx%d", ErrFont, width, height)
+	}
+	size := (width + 7) / 8 * height
+	if len(data) < len(Font{}.Glyphs)*size {
+		return nil, fmt.Errorf("%w: %d bytes of glyphs are truncated", ErrFont, len(data))
+	}
+	f := &Font{Width: width, Height: height}
+	for i := range f.Glyphs {
+		f.Glyphs[i] = data[i*size : (i+1)*size]
+	}
+	return f, nil
+}
+
+// pixel reports whether the pixel of the glyph at the column and row is set.
+func (f *Font) pixel(code byte, x, y int) bool {
+	stride := (f.Width + 7) / 8
+	return f.Glyphs[code][y*stride+x/8]&(0x80>>(x%8)) != 0
+}
+
+// fonts is the registry of the fonts addressable by name.
+//
+//nolint:gochecknoglobals
+var fonts = struct {
+	sync.RWMutex
+	names map[string]*Font
+}{
+	names: map[string]*Font{},
+}
+
+// RegisterFont adds the font to the registry with the name, such as "IBM VGA" or
+// "Amiga Topaz 1+", so that it can be found by [FontByName] and chosen by [DecodeFile]
+// using the font name of the SAUCE metadata. Registering an existing name replaces the font.
+// An empty name or a nil font returns [ErrFontName].
+func RegisterFont(name string, f *Font) error {
+	key := strings.ToLower(strings.TrimSpace(name))
+	if key == "" || f == nil {
+		return fmt.Errorf("%w: %q", ErrFontName, name)
+	}
+	fonts.Lock()
+	defer fonts.Unlock()
+	fonts.names[key] = f
+	return nil
+}
+
+// FontByName returns the registered font of the name, which is case-insensitive.
+// SAUCE font names can end with a code page number, such as "IBM VGA 437",
+// which finds the font registered as "IBM VGA" when the full name is not registered.
+// An unknown name returns [ErrFontName].
+func FontByName(name string) (*Font, error) {
+	key := strings.ToLower(strings.TrimSpace(name))
+	fonts.RLock()
+	defer fonts.RUnlock()
+	if f, ok := fonts.names[key]; ok {
+		return f, nil
+	}
+	if base, ok := fontCodePage(key); ok {
+		if f, ok := fonts.names[base]; ok {
+			return f, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", ErrFontName, name)
+}
+
+// Fonts returns the sorted names of the fonts available to [FontByName].
+func Fonts() []string {
+	fonts.RLock()
+	defer fonts.RUnlock()
+	names := make([]string, 0, len(fonts.names))
+	for name := range fonts.names {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// fontCodePage returns the font name without the code page number at the end,
+// such as "ibm vga" for "ibm vga 866".
+func fontCodePage(name string) (string, bool) {
+	i := strings.LastIndexByte(name, ' ')
+	if i < 0 {
+		return "", false
+	}
+	if _, err := strconv.Atoi(name[i+1:]); err != nil {
+		return "", false
+	}
+	return strings.TrimSpace(name[:i]), true
+}
+
+// SetFont sets the bitmap font used by [Grid.Image] and [Decoder.WriteDocument],
+// instead of the built-in font. A nil font restores the built-in font.
+func (g *Grid) SetFont(f *Font) {
+	g.font = f
+}
+
+// Font returns the bitmap font set by [Grid.SetFont], or nil for the built-in font.
+func (g *Grid) Font() *Font {
+	return g.font
+}
+
+// SetLetterSpacing9 sets the 9 pixel wide characters of the VGA text mode, where
+// the box-drawing and block characters of Code Page 437 repeat their eighth column
+// so the lines join, and the other characters have a blank ninth column.
+// It only applies to fonts 8 pixels wide, set with [Grid.SetFont].
+func (g *Grid) SetLetterSpacing9(on bool) {
+	g.nine = on
+}
+
+// renderFont returns the font used to render the grid, or nil for the built-in font.
+func (g *Grid) renderFont() *Font {
+	if g.font == nil || !g.nine || g.font.Width != 8 {
+		return g.font
+	}
+	return g.font.nine()
+}
+
+// nine returns a copy of the 8 pixel wide font with 9 pixel wide glyphs, where the
+// eighth column of the characters 0xC0 to 0xDF is repeated, as done by the VGA.
+func (f *Font) nine() *Font {
+	const first, last = 0xc0, 0xdf
+	n := &Font{Width: 9, Height: f.Height}
+	for code, glyph := range f.Glyphs {
+		g := make([]byte, 0, len(glyph)*2)
+		for _, row := range glyph {
+			var ninth byte
+			if code >= first && code <= last && row&1 != 0 {
+				ninth = 0x80
+			}
+			g = append(g, row, ninth)
+		}
+		n.Glyphs[code] = g
+	}
+	return n
+}
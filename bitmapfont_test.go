@@ -0,0 +1,81 @@
+package binbump_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/bengarrett/binbump"
+	"github.com/bengarrett/binbump/sauce"
+)
+
+func ExampleParseFont() {
+	// a PSF1 font header of 256 glyphs, 8x14 pixels
+	psf := []byte{0x36, 0x04, 0x00, 14}
+	psf = append(psf, bytes.Repeat([]byte{0x18}, 256*14)...)
+	font, err := binbump.ParseFont(psf)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(font.Width, font.Height, len(font.Glyphs['A']))
+	// Output: 8 14 14
+}
+
+func ExampleParseFont_psf2() {
+	// a PSF2 font header of 256 glyphs, 12x24 pixels
+	header := []uint32{0x864ab572, 0, 32, 0, 256, 2 * 24, 24, 12}
+	psf := binary.LittleEndian.AppendUint32(nil, header[0])
+	for _, v := range header[1:] {
+		psf = binary.LittleEndian.AppendUint32(psf, v)
+	}
+	psf = append(psf, make([]byte, 256*2*24)...)
+	font, err := binbump.ParseFont(psf)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(font.Width, font.Height, len(font.Glyphs['A']))
+	_, err = binbump.ParseFont([]byte("not a font"))
+	fmt.Println(err)
+	// Output: 12 24 48
+	// font data is not a PSF or raw VGA bitmap font: 10 bytes
+}
+
+func ExampleRegisterFont() {
+	font, err := binbump.ParseFont(bytes.Repeat([]byte{0x81}, 256*16))
+	if err != nil {
+		panic(err)
+	}
+	if err := binbump.RegisterFont("IBM VGA", font); err != nil {
+		panic(err)
+	}
+	// a screen dump with a SAUCE record for the IBM VGA font using 9 pixel letter spacing
+	var b bytes.Buffer
+	b.Write([]byte{0xc4, 0x07, 0x41, 0x07})
+	r := sauce.Bin(2)
+	r.Font = "IBM VGA 437"
+	r.Flags = sauce.LetterSpacing9
+	if err := sauce.Append(&b, r); err != nil {
+		panic(err)
+	}
+	dir, err := os.MkdirTemp("", "binbump")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "vga.bin")
+	if err := os.WriteFile(name, b.Bytes(), 0o644); err != nil {
+		panic(err)
+	}
+	d, err := binbump.DecodeFile(name)
+	if err != nil {
+		panic(err)
+	}
+	img := d.Grid().Image()
+	fmt.Println(binbump.Fonts(), img.Bounds())
+	// the ninth column of the box-drawing character repeats the eighth column
+	fmt.Println(img.At(8, 0), img.At(17, 0))
+	// Output: [ibm vga] (0,0)-(18,16)
+	// {170 170 170 255} {0 0 0 255}
+}
@@ -0,0 +1,39 @@
+package binbump
+
+import "slices"
+
+// BlinkFrames returns copies of the grid for both phases of the blink effect, where
+// the shown frame is the grid with the text of the blinking cells visible, and the hidden
+// frame replaces the characters of the blinking cells with spaces, leaving only their
+// background colors. Static exports of the frames, such as [Grid.WritePNG], can convey
+// what the blink effect hides and reveals.
+func (g *Grid) BlinkFrames() (*Grid, *Grid) {
+	shown, hidden := g.clone(), g.clone()
+	for _, row := range hidden.rows {
+		for x, c := range row {
+			if c.Blink() {
+				row[x].Char = ' '
+			}
+		}
+	}
+	return shown, hidden
+}
+
+// BlinkStack returns a copy of the grid with the rows of the hidden frame of the
+// [Grid.BlinkFrames] stacked below the rows of the shown frame, so that any renderer
+// exports both phases of the blink effect as a single image or document.
+func (g *Grid) BlinkStack() *Grid {
+	shown, hidden := g.BlinkFrames()
+	shown.rows = append(shown.rows, hidden.rows...)
+	return shown
+}
+
+// clone returns a copy of the grid that does not share the cells.
+func (g *Grid) clone() *Grid {
+	c := *g
+	c.rows = make([][]Cell, len(g.rows))
+	for y, row := range g.rows {
+		c.rows[y] = slices.Clone(row)
+	}
+	return &c
+}
@@ -0,0 +1,21 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_BlinkFrames() {
+	data := []byte("O\x07K\x07!\x8c")
+	d := binbump.NewDecoder(3, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	shown, hidden := d.Grid().BlinkFrames()
+	fmt.Printf("%q %q\n", shown.Transcript(), hidden.Transcript())
+	fmt.Printf("%q\n", d.Grid().BlinkStack().Transcript())
+	// Output: "OK!\n" "OK\n"
+	// "OK!\nOK\n"
+}
@@ -0,0 +1,392 @@
+package binbump
+
+import (
+	"math/bits"
+	"slices"
+)
+
+const (
+	brotliMinMatch  = 4
+	brotliMaxMatch  = 1 << 16
+	brotliMaxBlock  = 1 << 24 // the largest meta-block
+	brotliHashBits  = 15
+	brotliHashDepth = 32 // the most candidates tried for each match
+	brotliLiterals  = 256
+	brotliCommands  = 704
+	brotliDistances = 64 // the distance codes without direct codes or postfix bits
+)
+
+var (
+	// the base values and extra bits of the insert and copy length codes
+	brotliInsertBase = [24]int{
+		0, 1, 2, 3, 4, 5, 6, 8, 10, 14, 18, 26, 34, 50, 66, 98, 130, 194, 322, 578,
+		1090, 2114, 6210, 22594,
+	}
+	brotliInsertBits = [24]int{
+		0, 0, 0, 0, 0, 0, 1, 1, 2, 2, 3, 3, 4, 4, 5, 5, 6, 7, 8, 9, 10, 12, 14, 24,
+	}
+	brotliCopyBase = [24]int{
+		2, 3, 4, 5, 6, 7, 8, 9, 10, 12, 14, 18, 22, 30, 38, 54, 70, 102, 134, 198,
+		326, 582, 1094, 2118,
+	}
+	brotliCopyBits = [24]int{
+		0, 0, 0, 0, 0, 0, 0, 0, 1, 1, 2, 2, 3, 3, 4, 4, 5, 5, 6, 7, 8, 9, 10, 24,
+	}
+	// the order of the code length code lengths of a complex prefix code
+	brotliCodeLengthOrder = [18]int{1, 2, 3, 4, 0, 5, 17, 6, 16, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	// the fixed prefix code of the code length code lengths, as the value and bit count
+	brotliCodeLengthCode = [6][2]int{{0, 2}, {7, 4}, {3, 3}, {2, 2}, {1, 2}, {15, 4}}
+)
+
+// brotliCommand is an insert and copy command, which inserts the literals
+// and then copies the bytes from the distance back.
+type brotliCommand struct {
+	insert, copy, distance int
+}
+
+// brotli returns the data compressed in the Brotli format of RFC 7932.
+// The matches are found with a hash chain, and each meta-block uses a single
+// prefix code for each of the literals, the commands and the distances.
+func brotli(data []byte) []byte {
+	w := &bitWriter{}
+	window := 16
+	for window < 24 && 1<<window-16 < len(data) {
+		window++
+	}
+	switch window {
+	case 16:
+		w.write(0, 1)
+	case 17:
+		w.write(1, 7)
+	default:
+		w.write(1|(window-17)<<1, 4)
+	}
+	if len(data) == 0 {
+		w.write(3, 2) // the last meta-block, which is empty
+		return w.bytes()
+	}
+	m := &brotliMatcher{
+		data:    data,
+		head:    slices.Repeat([]int{-1}, 1<<brotliHashBits),
+		prev:    make([]int, len(data)),
+		maxDist: 1<<window - 16,
+	}
+	for start := 0; start < len(data); start += brotliMaxBlock {
+		end := min(start+brotliMaxBlock, len(data))
+		brotliMetaBlock(w, data[start:end], m.commands(start, end), end == len(data))
+	}
+	return w.bytes()
+}
+
+// brotliMetaBlock writes the compressed meta-block of the block of data.
+func brotliMetaBlock(w *bitWriter, block []byte, cmds []brotliCommand, last bool) {
+	if last {
+		w.write(1, 2) // the last meta-block, which is not empty
+	} else {
+		w.write(0, 1)
+	}
+	nibbles := 4
+	for nibbles < 6 && (len(block)-1)>>(nibbles*4) != 0 {
+		nibbles++
+	}
+	w.write(nibbles-4, 2)
+	w.write(len(block)-1, nibbles*4)
+	if !last {
+		w.write(0, 1) // compressed
+	}
+	w.write(0, 3) // one block type of literals, commands and distances
+	w.write(0, 6) // no postfix bits or direct distance codes
+	w.write(0, 2) // the literal context mode, which is unused
+	w.write(0, 2) // one literal and one distance prefix code
+	literals := make([]int, brotliLiterals)
+	commands := make([]int, brotliCommands)
+	distances := make([]int, brotliDistances)
+	pos := 0
+	for _, c := range cmds {
+		for _, b := range block[pos : pos+c.insert] {
+			literals[b]++
+		}
+		commands[brotliCommandCode(c)]++
+		if c.copy > 0 {
+			code, _, _ := brotliDistanceCode(c.distance)
+			distances[code]++
+		}
+		pos += c.insert + c.copy
+	}
+	litLens := huffmanLengths(literals, 15)
+	cmdLens := huffmanLengths(commands, 15)
+	distLens := huffmanLengths(distances, 15)
+	writePrefixCode(w, litLens)
+	writePrefixCode(w, cmdLens)
+	writePrefixCode(w, distLens)
+	litCodes, cmdCodes, distCodes := prefixCodes(litLens), prefixCodes(cmdLens), prefixCodes(distLens)
+	pos = 0
+	for _, c := range cmds {
+		code := brotliCommandCode(c)
+		w.write(cmdCodes[code], int(cmdLens[code]))
+		ins := brotliLengthCode(brotliInsertBase[:], c.insert)
+		w.write(c.insert-brotliInsertBase[ins], brotliInsertBits[ins])
+		cp := brotliLengthCode(brotliCopyBase[:], max(c.copy, 2))
+		w.write(max(c.copy, 2)-brotliCopyBase[cp], brotliCopyBits[cp])
+		for _, b := range block[pos : pos+c.insert] {
+			w.write(litCodes[b], int(litLens[b]))
+		}
+		if c.copy > 0 {
+			dist, extra, n := brotliDistanceCode(c.distance)
+			w.write(distCodes[dist], int(distLens[dist]))
+			w.write(extra, n)
+		}
+		pos += c.insert + c.copy
+	}
+}
+
+// brotliMatcher finds the matches of the data, within the window of earlier data.
+type brotliMatcher struct {
+	data       []byte
+	head, prev []int // the hash chains of the positions
+	maxDist    int
+}
+
+// hash inserts the position into the hash chains.
+func (m *brotliMatcher) hash(pos int) {
+	if pos+brotliMinMatch > len(m.data) {
+		return
+	}
+	h := (uint32(m.data[pos]) | uint32(m.data[pos+1])<<8 | uint32(m.data[pos+2])<<16 |
+		uint32(m.data[pos+3])<<24) * 0x1e35a7bd >> (32 - brotliHashBits)
+	m.prev[pos], m.head[h] = m.head[h], pos
+}
+
+// match returns the length and distance of the longest match at the position,
+// which ends before the end, or a length of 0 when there is no match.
+func (m *brotliMatcher) match(pos, end int) (int, int) {
+	if pos+brotliMinMatch > end {
+		return 0, 0
+	}
+	limit := min(end-pos, brotliMaxMatch)
+	length, distance := 0, 0
+	cand := m.prev[pos]
+	for range brotliHashDepth {
+		if cand < 0 || pos-cand > m.maxDist {
+			break
+		}
+		n := 0
+		for n < limit && m.data[cand+n] == m.data[pos+n] {
+			n++
+		}
+		if n > length {
+			length, distance = n, pos-cand
+		}
+		cand = m.prev[cand]
+	}
+	if length < brotliMinMatch {
+		return 0, 0
+	}
+	return length, distance
+}
+
+// commands returns the greedy insert and copy commands of the data from start to end,
+// where the last command only inserts the remaining literals.
+func (m *brotliMatcher) commands(start, end int) []brotliCommand {
+	cmds := []brotliCommand{}
+	insert := 0
+	for pos := start; pos < end; {
+		m.hash(pos)
+		length, distance := m.match(pos, end)
+		if length == 0 {
+			insert++
+			pos++
+			continue
+		}
+		cmds = append(cmds, brotliCommand{insert, length, distance})
+		insert = 0
+		for i := pos + 1; i < pos+length; i++ {
+			m.hash(i)
+		}
+		pos += length
+	}
+	if insert > 0 {
+		cmds = append(cmds, brotliCommand{insert: insert})
+	}
+	return cmds
+}
+
+// brotliLengthCode returns the code of the insert or copy length.
+func brotliLengthCode(base []int, n int) int {
+	code := 0
+	for code+1 < len(base) && base[code+1] <= n {
+		code++
+	}
+	return code
+}
+
+// brotliCommandCode returns the insert and copy length code of the command,
+// which always reads an explicit distance code.
+func brotliCommandCode(c brotliCommand) int {
+	ins := brotliLengthCode(brotliInsertBase[:], c.insert)
+	cp := brotliLengthCode(brotliCopyBase[:], max(c.copy, 2))
+	cells := [3][3]int{{128, 192, 384}, {256, 320, 512}, {448, 576, 640}}
+	return cells[ins>>3][cp>>3] + ins&7<<3 + cp&7
+}
+
+// brotliDistanceCode returns the distance code of the distance,
+// and the value and number of its extra bits.
+func brotliDistanceCode(distance int) (int, int, int) {
+	d := distance + 3
+	n := bits.Len(uint(d)) - 2 //nolint:gosec
+	high := d >> n & 1
+	return 16 + (n-1)*2 + high, d & (1<<n - 1), n
+}
+
+// huffmanLengths returns the code lengths of a prefix code of the symbol frequencies,
+// which are no longer than the limit. The code has at least two symbols, as a complete
+// code is required by Brotli.
+func huffmanLengths(freq []int, limit int) []uint8 {
+	f := slices.Clone(freq)
+	for used := 0; used < 2; {
+		used = 0
+		for _, n := range f {
+			if n > 0 {
+				used++
+			}
+		}
+		if used < 2 {
+			f[slices.IndexFunc(f, func(n int) bool { return n == 0 })] = 1
+		}
+	}
+	type node struct{ weight, left, right int }
+	for {
+		nodes := []node{}
+		for sym, n := range f {
+			if n > 0 {
+				nodes = append(nodes, node{n, -1, sym})
+			}
+		}
+		slices.SortStableFunc(nodes, func(a, b node) int { return a.weight - b.weight })
+		// the leaves and the merged nodes are both queues sorted by weight
+		leaves, merged := len(nodes), len(nodes)
+		next := 0
+		pop := func() int {
+			if next < leaves && (merged == len(nodes) || nodes[next].weight <= nodes[merged].weight) {
+				next++
+				return next - 1
+			}
+			merged++
+			return merged - 1
+		}
+		for len(nodes)-merged+leaves-next > 1 {
+			a, b := pop(), pop()
+			nodes = append(nodes, node{nodes[a].weight + nodes[b].weight, a, b})
+		}
+		lengths := make([]uint8, len(f))
+		deepest := 0
+		var walk func(i, depth int)
+		walk = func(i, depth int) {
+			if nodes[i].left < 0 {
+				lengths[nodes[i].right] = uint8(depth) //nolint:gosec
+				deepest = max(deepest, depth)
+				return
+			}
+			walk(nodes[i].left, depth+1)
+			walk(nodes[i].right, depth+1)
+		}
+		walk(len(nodes)-1, 0)
+		if deepest <= limit {
+			return lengths
+		}
+		for i, n := range f {
+			if n > 0 {
+				f[i] = (n + 1) / 2
+			}
+		}
+	}
+}
+
+// prefixCodes returns the canonical prefix codes of the code lengths,
+// with the bits reversed to be written from the first bit of the code.
+func prefixCodes(lengths []uint8) []int {
+	count := make([]int, slices.Max(lengths)+2)
+	for _, n := range lengths {
+		count[n]++
+	}
+	count[0] = 0
+	next := make([]int, len(count))
+	for n := 1; n < len(count); n++ {
+		next[n] = (next[n-1] + count[n-1]) << 1
+	}
+	codes := make([]int, len(lengths))
+	for sym, n := range lengths {
+		if n == 0 {
+			continue
+		}
+		codes[sym] = int(bits.Reverse32(uint32(next[n])) >> (32 - n)) //nolint:gosec
+		next[n]++
+	}
+	return codes
+}
+
+// writePrefixCode writes the code lengths as a complex prefix code, where each length
+// is a symbol of the code length code. When there is a single code length, it uses no
+// bits and the code length code lengths are written in full.
+func writePrefixCode(w *bitWriter, lengths []uint8) {
+	last := len(lengths) - 1
+	for lengths[last] == 0 {
+		last--
+	}
+	freq := make([]int, len(brotliCodeLengthOrder))
+	for _, n := range lengths[:last+1] {
+		freq[n]++
+	}
+	single := slices.IndexFunc(freq, func(n int) bool { return n == last+1 }) >= 0
+	clens := make([]uint8, len(freq))
+	if single {
+		clens[lengths[0]] = 1
+	} else {
+		clens = huffmanLengths(freq, 5)
+	}
+	w.write(0, 2) // complex prefix code without skipped code lengths
+	order := brotliCodeLengthOrder[:]
+	if !single {
+		for clens[order[len(order)-1]] == 0 {
+			order = order[:len(order)-1]
+		}
+	}
+	for _, sym := range order {
+		c := brotliCodeLengthCode[clens[sym]]
+		w.write(c[0], c[1])
+	}
+	if single {
+		return
+	}
+	codes := prefixCodes(clens)
+	for _, n := range lengths[:last+1] {
+		w.write(codes[n], int(clens[n]))
+	}
+}
+
+// bitWriter writes values as bits, starting from the least significant bit of a byte.
+type bitWriter struct {
+	buf  []byte
+	bits uint64
+	n    int
+}
+
+// write writes the n low bits of the value.
+func (w *bitWriter) write(value, n int) {
+	w.bits |= uint64(value) << w.n //nolint:gosec
+	w.n += n
+	for w.n >= 8 {
+		w.buf = append(w.buf, byte(w.bits))
+		w.bits >>= 8
+		w.n -= 8
+	}
+}
+
+// bytes returns the written bits, padded with zero bits to a whole byte.
+func (w *bitWriter) bytes() []byte {
+	if w.n > 0 {
+		w.buf = append(w.buf, byte(w.bits))
+		w.bits, w.n = 0, 0
+	}
+	return w.buf
+}
@@ -0,0 +1,124 @@
+package binbump
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Cache stores rendered HTML fragments by key, so that HTTP handlers and batch jobs
+// don't re-render identical screens. Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, html []byte)
+}
+
+// WithCache sets the cache of the HTML fragments written by [Decoder.Write],
+// which are keyed by the cells and width of the grid and the render settings of the Decoder.
+//
+// When the fragment is found in the cache, the [Decoder.Stats] only include the Bytes.
+func WithCache(c Cache) Option {
+	return func(d *Decoder) {
+		d.cache = c
+	}
+}
+
+// cacheKey returns the key of the grid rendered with the settings of the Decoder.
+// Unlike the [Grid.Fingerprint], every cell is hashed, as the trailing blank cells
+// are also rendered, and whether the cell is blank, which may be set by [Grid.SetBlank].
+func (d *Decoder) cacheKey() string {
+	var open strings.Builder
+	d.openElement(&open, d.elementStyle())
+	settings := fmt.Sprintf("%s|%v|%t|%t|%t|%t|%t|%t|%t|%q|%d|%q|%q|%q|%q|%q|%d",
+		d.grid.charset, d.grid.colors, d.grid.mda,
+		d.Debug, d.Optimize, d.ASCII, d.Minify, d.Links, d.Trace,
+		d.Indent, d.Separator, d.RowID, d.RowClass, d.ClassPrefix, open.String(), d.Space, d.ChunkRows)
+	sum := sha256.Sum256([]byte(settings))
+	return d.grid.cellsHash() + "-" + hex.EncodeToString(sum[:])
+}
+
+// cellsHash returns the hexadecimal SHA-256 hash of the width and all the cells of the grid.
+func (g *Grid) cellsHash() string {
+	h := sha256.New()
+	h.Write(binary.BigEndian.AppendUint64(nil, uint64(g.width))) //nolint:gosec
+	for _, row := range g.rows {
+		for _, c := range row {
+			var blank byte
+			if g.Blank(c) {
+				blank = 1
+			}
+			h.Write([]byte{c.Char, c.Attr, blank})
+		}
+		h.Write([]byte{'\n', 0, 0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// MemoryCache is an in-memory [Cache] that discards the least recently used
+// fragments when the maximum number of entries is reached.
+type MemoryCache struct {
+	mu      sync.Mutex
+	max     int
+	order   *list.List // order holds the keys, from the most to the least recently used
+	entries map[string]*list.Element
+}
+
+// memoryEntry is an element value of a MemoryCache.
+type memoryEntry struct {
+	key  string
+	html []byte
+}
+
+// NewMemoryCache creates a MemoryCache that holds up to size fragments.
+// If size <= 0, 128 is used.
+func NewMemoryCache(size int) *MemoryCache {
+	const entries = 128
+	if size <= 0 {
+		size = entries
+	}
+	return &MemoryCache{
+		max:     size,
+		order:   list.New(),
+		entries: map[string]*list.Element{},
+	}
+}
+
+// Get returns the fragment of the key.
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	m.order.MoveToFront(e)
+	return e.Value.(*memoryEntry).html, true //nolint:forcetypeassert
+}
+
+// Set stores the fragment of the key.
+func (m *MemoryCache) Set(key string, html []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if e, ok := m.entries[key]; ok {
+		e.Value.(*memoryEntry).html = html //nolint:forcetypeassert
+		m.order.MoveToFront(e)
+		return
+	}
+	m.entries[key] = m.order.PushFront(&memoryEntry{key: key, html: html})
+	for m.order.Len() > m.max {
+		last := m.order.Back()
+		m.order.Remove(last)
+		delete(m.entries, last.Value.(*memoryEntry).key) //nolint:forcetypeassert
+	}
+}
+
+// Len returns the number of fragments in the cache.
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.order.Len()
+}
@@ -0,0 +1,58 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleWithCache() {
+	cache := binbump.NewMemoryCache(0)
+	files := [][]byte{
+		{0x48, 0x1e, 0x69, 0x1e},
+		{0x48, 0x1e, 0x69, 0x1e},
+	}
+	for _, data := range files {
+		d := binbump.NewDecoder(0, 0, binbump.StandardCGA, nil, binbump.WithCache(cache))
+		if err := d.Read(bytes.NewReader(data)); err != nil {
+			panic(err)
+		}
+		if err := d.Write(os.Stdout); err != nil {
+			panic(err)
+		}
+		fmt.Println()
+	}
+	fmt.Println(cache.Len(), "cached")
+	// Output: <div><span style="color:#ff5;background-color:#00a;">Hi</span>
+	// </div>
+	// <div><span style="color:#ff5;background-color:#00a;">Hi</span>
+	// </div>
+	// 1 cached
+}
+
+func ExampleWithCache_debug() {
+	cache := binbump.NewMemoryCache(0)
+	files := [][]byte{
+		{'A', 0x07},
+		{'A', 0x07, ' ', 0x07, ' ', 0x07},
+	}
+	for _, data := range files {
+		d := binbump.NewDecoder(0, 0, binbump.StandardCGA, nil, binbump.WithCache(cache))
+		d.Debug = true
+		if err := d.Read(bytes.NewReader(data)); err != nil {
+			panic(err)
+		}
+		if err := d.Write(os.Stdout); err != nil {
+			panic(err)
+		}
+		fmt.Println()
+	}
+	fmt.Println(cache.Len(), "cached")
+	// Output: <div><span data-xy="1x1" style="color:#aaa;background-color:#000;">A</span>
+	// </div>
+	// <div><span data-xy="1x1" style="color:#aaa;background-color:#000;">A</span><span data-xy="1x2" style="color:#aaa;background-color:#000;"> </span><span data-xy="1x3" style="color:#aaa;background-color:#000;"> </span>
+	// </div>
+	// 2 cached
+}
@@ -0,0 +1,190 @@
+package binbump
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"strconv"
+)
+
+// canvasScript is the script of the binbumpCanvas function that draws
+// the JSON of [Grid.MarshalCanvas] onto a canvas element.
+const canvasScript = `function binbumpCanvas(canvas, data) {
+  "use strict";
+  const cw = data.cellWidth, ch = data.cellHeight;
+  const cells = Uint8Array.from(atob(data.cells), function (c) { return c.charCodeAt(0); });
+  canvas.width = data.columns * cw;
+  canvas.height = data.rows * ch;
+  const ctx = canvas.getContext("2d");
+  const atlas = new Image();
+  atlas.onload = function () {
+    const tints = data.colors.map(function (hex) {
+      const tint = document.createElement("canvas");
+      tint.width = atlas.width;
+      tint.height = atlas.height;
+      const t = tint.getContext("2d");
+      t.drawImage(atlas, 0, 0);
+      t.globalCompositeOperation = "source-in";
+      t.fillStyle = "#" + hex;
+      t.fillRect(0, 0, tint.width, tint.height);
+      return tint;
+    });
+    for (let i = 0; i + 2 < cells.length; i += 3) {
+      const code = cells[i], fg = cells[i + 1] & 0x0f, bg = cells[i + 1] >> 4, flags = cells[i + 2];
+      if (flags & 0x80) {
+        continue;
+      }
+      const n = i / 3, x = (n % data.columns) * cw, y = Math.floor(n / data.columns) * ch;
+      ctx.fillStyle = "#" + data.colors[bg];
+      ctx.fillRect(x, y, cw, ch);
+      ctx.drawImage(tints[fg], (code % 16) * cw, Math.floor(code / 16) * ch, cw, ch, x, y, cw, ch);
+      if (flags & 0x01) {
+        ctx.fillStyle = "#" + data.colors[fg];
+        ctx.fillRect(x, y + ch - 1, cw, 1);
+      }
+    }
+  };
+  atlas.src = data.atlas;
+}
+`
+
+// Flags of the cells in the JSON of [Grid.MarshalCanvas].
+const (
+	canvasUnderline = 0x01 // the cell is underlined
+	canvasEmpty     = 0x80 // the cell is past the end of a short row
+)
+
+// canvasGrid is the JSON representation of a grid drawn onto a canvas.
+type canvasGrid struct {
+	Columns    int      `json:"columns"`
+	Rows       int      `json:"rows"`
+	CellWidth  int      `json:"cellWidth"`
+	CellHeight int      `json:"cellHeight"`
+	Colors     []string `json:"colors"`
+	Atlas      string   `json:"atlas"`
+	Cells      string   `json:"cells"`
+}
+
+// MarshalCanvas returns the grid as compact JSON for the script of [WriteCanvasScript],
+// which draws the grid onto a canvas element with the pixels of the [Grid.Image].
+//
+// The JSON object contains the number of columns and rows, the size in pixels of a cell,
+// the hexadecimal colorset, and the font atlas as a PNG data URL of 16 by 16 white glyphs
+// on a transparent background. The cells are base64 encoded as 3 bytes per cell,
+// the character code, the background and foreground color codes in the high and low
+// nibbles, and the flags, where 0x01 is underlined and 0x80 is an empty cell.
+func (g *Grid) MarshalCanvas() ([]byte, error) {
+	atlas, err := g.atlas()
+	if err != nil {
+		return nil, fmt.Errorf("grid marshal canvas: %w", err)
+	}
+	const size = 3
+	cells := make([]byte, 0, g.width*len(g.rows)*size)
+	for _, row := range g.rows {
+		for x := range g.width {
+			if x >= len(row) {
+				cells = append(cells, 0, 0, canvasEmpty)
+				continue
+			}
+			c := row[x]
+			fg, bg := g.attrColors(c)
+			var flags byte
+			if g.underline(c) {
+				flags |= canvasUnderline
+			}
+			cells = append(cells, c.Char, bg<<4|fg, flags)
+		}
+	}
+	w, h := g.cellSize()
+	v := canvasGrid{
+		Columns:    g.width,
+		Rows:       len(g.rows),
+		CellWidth:  w,
+		CellHeight: h,
+		Colors:     g.colors.hexes(),
+		Atlas:      "data:image/png;base64," + base64.StdEncoding.EncodeToString(atlas),
+		Cells:      base64.StdEncoding.EncodeToString(cells),
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("grid marshal canvas: %w", err)
+	}
+	return b, nil
+}
+
+// atlas returns the PNG image of the 256 characters of the charset drawn with the font
+// of the grid, as 16 rows of 16 glyphs that are white on a transparent background.
+func (g *Grid) atlas() ([]byte, error) {
+	const glyphs, white = 16, 0x0f
+	a := &Grid{charset: g.charset, colors: CGA(), font: g.font, nine: g.nine}
+	f := a.renderFont()
+	w, h := a.cellSize()
+	img := image.NewRGBA(image.Rect(0, 0, glyphs*w, glyphs*h))
+	for code := range glyphs * glyphs {
+		c := Cell{Char: byte(code), Attr: white} //nolint:gosec
+		pt := image.Pt(code%glyphs*w, code/glyphs*h)
+		if f != nil {
+			a.drawGlyph(img, pt, c, f)
+			continue
+		}
+		a.drawCell(img, pt, c)
+	}
+	fg := rgba(White)
+	mask := image.NewAlpha(img.Bounds())
+	for y := range img.Bounds().Dy() {
+		for x := range img.Bounds().Dx() {
+			if img.RGBAAt(x, y) == fg {
+				mask.SetAlpha(x, y, color.Alpha{A: 0xff})
+			}
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, mask); err != nil {
+		return nil, fmt.Errorf("atlas: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// WriteCanvasScript writes to w the JavaScript of the binbumpCanvas(canvas, data) function,
+// which draws the parsed JSON of [Grid.MarshalCanvas] onto the canvas element,
+// so that galleries can render many screens as pixels instead of spans.
+//
+//	fetch("art.json").then(r => r.json()).then(data => binbumpCanvas(canvas, data));
+func WriteCanvasScript(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	if _, err := io.WriteString(w, canvasScript); err != nil {
+		return fmt.Errorf("write canvas script: %w", err)
+	}
+	return nil
+}
+
+// WriteCanvas writes to w a canvas element of the grid, followed by a script element
+// with the function of [WriteCanvasScript] that draws the JSON of [Grid.MarshalCanvas].
+func (g *Grid) WriteCanvas(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	b, err := g.MarshalCanvas()
+	if err != nil {
+		return err
+	}
+	cw, ch := g.cellSize()
+	out := bufio.NewWriter(w)
+	out.WriteString(`<canvas width="` + strconv.Itoa(g.width*cw) + `" height="` +
+		strconv.Itoa(len(g.rows)*ch) + `"></canvas>` + "\n<script>\n" + canvasScript +
+		"binbumpCanvas(document.currentScript.previousElementSibling, ")
+	out.Write(b)
+	out.WriteString(");\n</script>\n")
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("write canvas flush: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package binbump_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_MarshalCanvas() {
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.Read(strings.NewReader("H\x07i\x1e")); err != nil {
+		log.Fatal(err)
+	}
+	b, err := d.Grid().MarshalCanvas()
+	if err != nil {
+		log.Fatal(err)
+	}
+	var v struct {
+		Columns    int    `json:"columns"`
+		Rows       int    `json:"rows"`
+		CellWidth  int    `json:"cellWidth"`
+		CellHeight int    `json:"cellHeight"`
+		Atlas      string `json:"atlas"`
+		Cells      string `json:"cells"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(v.Columns, v.Rows, v.CellWidth, v.CellHeight)
+	fmt.Println(strings.HasPrefix(v.Atlas, "data:image/png;base64,"))
+	fmt.Println(v.Cells)
+	// Output: 2 1 8 16
+	// true
+	// SAcAaR4A
+}
@@ -0,0 +1,164 @@
+package binbump
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/bengarrett/binbump/sauce"
+	"golang.org/x/text/encoding/charmap"
+)
+
+// charsets are the DOS code pages addressable by name.
+//
+//nolint:gochecknoglobals
+var charsets = map[string]*charmap.Charmap{
+	"cp437": charmap.CodePage437,
+	"cp850": charmap.CodePage850,
+	"cp852": charmap.CodePage852,
+	"cp855": charmap.CodePage855,
+	"cp858": charmap.CodePage858,
+	"cp860": charmap.CodePage860,
+	"cp862": charmap.CodePage862,
+	"cp863": charmap.CodePage863,
+	"cp865": charmap.CodePage865,
+	"cp866": charmap.CodePage866,
+}
+
+// CharsetByName returns the DOS code page charset of the name, so that command-line tools
+// and configuration files can select charsets with strings. The name is case-insensitive
+// and can be written as "cp866", "ibm866", "866" or "IBM Code Page 866".
+// An unknown name returns [ErrCharset].
+func CharsetByName(name string) (*charmap.Charmap, error) {
+	s := strings.ToLower(strings.TrimSpace(name))
+	for _, prefix := range []string{"ibm code page", "code page", "codepage", "cp", "ibm"} {
+		if after, ok := strings.CutPrefix(s, prefix); ok {
+			s = after
+			break
+		}
+	}
+	s = strings.TrimLeft(s, " -_")
+	if cs, ok := charsets["cp"+s]; ok {
+		return cs, nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrCharset, name)
+}
+
+// Charsets returns the sorted names of the charsets available to [CharsetByName].
+func Charsets() []string {
+	return slices.Sorted(maps.Keys(charsets))
+}
+
+// detectCharsets are the candidate charsets of [DetectCharset], in order of preference.
+//
+//nolint:gochecknoglobals
+var detectCharsets = []*charmap.Charmap{
+	charmap.CodePage437,
+	charmap.CodePage850,
+	charmap.CodePage852,
+	charmap.CodePage866,
+	charmap.CodePage862,
+}
+
+// DetectCharset returns the likeliest charset of the binary screen dump data,
+// so that Cyrillic, Central European and other non-US dumps are not assumed to be IBM Code Page 437.
+// The candidates are Code Pages 437, 850, 852, 866 and 862. The Greek Code Page 737
+// is not a candidate, as it is not one of the charsets of [golang.org/x/text/encoding/charmap].
+//
+// A SAUCE record with a font name that includes a candidate code page, such as "IBM VGA 866",
+// is used as a hint. Otherwise, the characters are decoded with each candidate and the words
+// containing non-ASCII letters are scored, where a word of letters from a single script
+// of the Latin, Cyrillic or Hebrew scripts is likely, and a word mixing scripts,
+// or a Latin word of only accented letters, is not.
+// If no candidate scores higher, Code Page 437 is returned.
+func DetectCharset(data []byte) *charmap.Charmap {
+	if cs := sauceCharset(data); cs != nil {
+		return cs
+	}
+	data = TrimMetadata(data)
+	best, high := charmap.CodePage437, 0
+	for _, cs := range detectCharsets {
+		if score := charsetScore(data, cs); score > high {
+			best, high = cs, score
+		}
+	}
+	return best
+}
+
+// sauceCharset returns the candidate charset named by the font of a SAUCE record in data, or nil.
+func sauceCharset(data []byte) *charmap.Charmap {
+	r, err := sauce.Decode(data)
+	if err != nil {
+		return nil
+	}
+	fields := strings.Fields(r.Font)
+	if len(fields) == 0 {
+		return nil
+	}
+	code := fields[len(fields)-1]
+	for _, cs := range detectCharsets {
+		if strings.HasSuffix(cs.String(), " "+code) {
+			return cs
+		}
+	}
+	return nil
+}
+
+// charsetScore returns the score of the words of the data decoded with the charset.
+func charsetScore(data []byte, cs *charmap.Charmap) int {
+	const pair = 2
+	score := 0
+	word := []rune{}
+	end := func() {
+		score += wordScore(word)
+		word = word[:0]
+	}
+	for i := 0; i < len(data); i += pair {
+		r := cs.DecodeByte(data[i])
+		if !unicode.IsLetter(r) {
+			end()
+			continue
+		}
+		word = append(word, r)
+	}
+	end()
+	return score
+}
+
+// wordScore returns the length of the word when it is likely to be written in a single script,
+// or the negative length when it is not. Words of only ASCII letters, and single letters
+// such as the Greek math symbols of Code Page 437, have no score.
+func wordScore(word []rune) int {
+	if len(word) < 2 { //nolint:mnd
+		return 0
+	}
+	scripts := []*unicode.RangeTable{unicode.Latin, unicode.Cyrillic, unicode.Hebrew}
+	const lastASCII = 0x7f
+	accented := 0
+	var script *unicode.RangeTable
+	for _, r := range word {
+		if r > lastASCII && unicode.Is(unicode.Latin, r) {
+			accented++
+		}
+		var rs *unicode.RangeTable
+		for _, s := range scripts {
+			if unicode.Is(s, r) {
+				rs = s
+				break
+			}
+		}
+		if rs == nil || (script != nil && rs != script) {
+			return -len(word)
+		}
+		script = rs
+	}
+	if script == nil || (script == unicode.Latin && accented == 0) {
+		return 0
+	}
+	if script == unicode.Latin && accented == len(word) {
+		return -len(word)
+	}
+	return len(word)
+}
@@ -0,0 +1,64 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+	"github.com/bengarrett/binbump/sauce"
+	"golang.org/x/text/encoding/charmap"
+)
+
+// bin returns s encoded with the charset as a binary screen dump with a gray on black attribute.
+func bin(s string, cs *charmap.Charmap) []byte {
+	p, err := cs.NewEncoder().Bytes([]byte(s))
+	if err != nil {
+		panic(err)
+	}
+	data := make([]byte, 0, len(p)*2)
+	for _, b := range p {
+		data = append(data, b, 0x07)
+	}
+	return data
+}
+
+func ExampleDetectCharset() {
+	fmt.Println(binbump.DetectCharset(bin("╔══╗ Café ░▒▓█", charmap.CodePage437)))
+	fmt.Println(binbump.DetectCharset(bin("╔══╗ Добро пожаловать ░▒▓█", charmap.CodePage866)))
+	fmt.Println(binbump.DetectCharset(bin("Zażółć gęślą jaźń", charmap.CodePage852)))
+
+	var b bytes.Buffer
+	b.Write(bin("BBS", charmap.CodePage866))
+	r := sauce.Bin(80)
+	r.Font = "IBM VGA 866"
+	if err := sauce.Append(&b, r); err != nil {
+		panic(err)
+	}
+	fmt.Println(binbump.DetectCharset(b.Bytes()))
+	// Output: IBM Code Page 437
+	// IBM Code Page 866
+	// IBM Code Page 852
+	// IBM Code Page 866
+}
+
+func ExampleCharsetByName() {
+	for _, name := range []string{"cp866", "IBM850", "437", "IBM Code Page 852"} {
+		cs, err := binbump.CharsetByName(name)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(cs)
+	}
+	_, err := binbump.CharsetByName("cp737")
+	fmt.Println(err)
+	// Output: IBM Code Page 866
+	// IBM Code Page 850
+	// IBM Code Page 437
+	// IBM Code Page 852
+	// charset name is unknown: "cp737"
+}
+
+func ExampleCharsets() {
+	fmt.Println(binbump.Charsets())
+	// Output: [cp437 cp850 cp852 cp855 cp858 cp860 cp862 cp863 cp865 cp866]
+}
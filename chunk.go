@@ -0,0 +1,32 @@
+package binbump
+
+import "strconv"
+
+// chunkStart writes the opening tag of the container of the chunk of rows,
+// when y is the first row number of a chunk.
+func (w *htmlWriter) chunkStart(y int) {
+	if w.chunk <= 0 || (y-1)%w.chunk != 0 {
+		return
+	}
+	rows := min(w.chunk, w.rows-y+1)
+	w.indentLine(1)
+	w.WriteString(`<div style="content-visibility:auto;contain-intrinsic-block-size:auto `)
+	w.num = strconv.AppendInt(w.num[:0], int64(rows), 10)
+	w.Write(w.num)
+	w.WriteString(`lh;">`)
+}
+
+// chunkEnd writes the closing tag of the container of the chunk of rows,
+// when y is the last row number of a chunk.
+func (w *htmlWriter) chunkEnd(y int) {
+	if !w.lastOfChunk(y) {
+		return
+	}
+	w.indentLine(1)
+	w.WriteString("</div>")
+}
+
+// lastOfChunk reports whether y is the last row number of a chunk of rows.
+func (w *htmlWriter) lastOfChunk(y int) bool {
+	return w.chunk > 0 && (y%w.chunk == 0 || y == w.rows)
+}
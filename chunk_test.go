@@ -0,0 +1,25 @@
+package binbump_test
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleDecoder_Write_chunkRows() {
+	data := []byte{0x41, 0x07, 0x42, 0x07, 0x43, 0x07}
+	d := binbump.NewDecoder(1, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	d.ChunkRows = 2
+	d.Optimize = true
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: <div><div style="content-visibility:auto;contain-intrinsic-block-size:auto 2lh;"><span style="color:#aaa;background-color:#000;">A
+	// B</span>
+	// </div><div style="content-visibility:auto;contain-intrinsic-block-size:auto 1lh;"><span style="color:#aaa;background-color:#000;">C</span>
+	// </div></div>
+}
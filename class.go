@@ -0,0 +1,67 @@
+package binbump
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// classPattern matches the valid class prefixes, which are CSS identifiers.
+//
+//nolint:gochecknoglobals
+var classPattern = regexp.MustCompile(`^-?[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+// classPrefix returns the prefix of the generated classes, which is "bb"
+// when the ClassPrefix is not a valid CSS identifier.
+func (d *Decoder) classPrefix() string {
+	if classPattern.MatchString(d.ClassPrefix) {
+		return d.ClassPrefix
+	}
+	return "bb"
+}
+
+// writeClasses writes a <style> element with a generated class for each unique style
+// used by the grid, and replaces the cached styles of w with the class names.
+// In Optimize mode, the classes include the background styles of the blank cells,
+// which are unused when the blank cells join the span of a neighbor.
+func (d *Decoder) writeClasses(w *htmlWriter) {
+	optimize := d.Optimize && !d.Debug && !d.Trace
+	prefix := d.classPrefix()
+	classes := map[string]string{}
+	order := []string{}
+	add := func(style string) {
+		if style == "" {
+			return
+		}
+		if _, ok := classes[style]; ok {
+			return
+		}
+		classes[style] = prefix + strconv.Itoa(len(order))
+		order = append(order, style)
+	}
+	for _, row := range d.grid.rows {
+		for _, c := range row {
+			if optimize && d.grid.Blank(c) && !d.grid.underline(c) {
+				_, bg := d.grid.attrColors(c)
+				add(w.bgStyles[bg])
+				continue
+			}
+			add(w.styles[c.Attr])
+		}
+	}
+	w.WriteString("<style>")
+	for _, style := range order {
+		w.WriteByte('.')
+		w.WriteString(classes[style])
+		w.WriteByte('{')
+		w.WriteString(style)
+		w.WriteByte('}')
+	}
+	w.WriteString("</style>")
+	for i, style := range w.styles {
+		w.styles[i] = classes[style]
+	}
+	for i, style := range w.bgStyles {
+		w.bgStyles[i] = classes[style]
+	}
+	w.attr = ` class="`
+}
@@ -0,0 +1,46 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleDecoder_Write_classPrefix() {
+	data := []byte{0x41, 0x07, 0x42, 0x1f, 0x20, 0x07, 0x43, 0x07}
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	d.ClassPrefix = "bb"
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	d.Optimize = true
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: <div><style>.bb0{color:#aaa;background-color:#000;}.bb1{color:#fff;background-color:#00a;}</style><span class="bb0">A</span><span class="bb1">B</span>
+	// <span class="bb0"> C</span>
+	// </div>
+	// <div><style>.bb0{color:#aaa;background-color:#000;}.bb1{color:#fff;background-color:#00a;}.bb2{background-color:#000;}</style><span class="bb0">A</span><span class="bb1">B
+	// </span><span class="bb2"> </span><span class="bb0">C
+	// </span></div>
+}
+
+func ExampleDecoder_Write_invalidClassPrefix() {
+	data := []byte{0x41, 0x07}
+	d := binbump.NewDecoder(1, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	d.ClassPrefix = `x"><script>`
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: <div><style>.bb0{color:#aaa;background-color:#000;}</style><span class="bb0">A</span>
+	// </div>
+}
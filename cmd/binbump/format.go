@@ -0,0 +1,155 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/bengarrett/binbump"
+)
+
+var errFormat = errors.New("unknown format")
+
+// format is an output format of the command.
+type format struct {
+	ext    string           // ext is the file extension of the output
+	stdout bool             // stdout writes to the standard output by default
+	write  binbump.Renderer // write renders the decoded file
+}
+
+// formats are the output formats, by name.
+//
+//nolint:gochecknoglobals
+var formats = map[string]format{
+	"html": {ext: ".html", write: func(d *binbump.Decoder, w io.Writer) error {
+		return d.Write(w)
+	}},
+	"ansi": {ext: ".ansi", stdout: true, write: func(d *binbump.Decoder, w io.Writer) error {
+		return d.Grid().WriteANSI(w, binbump.TrueColor)
+	}},
+	"text": {ext: ".txt", write: func(d *binbump.Decoder, w io.Writer) error {
+		_, err := io.WriteString(w, d.Grid().Transcript())
+		return err //nolint:wrapcheck
+	}},
+	"svg": {ext: ".svg", write: func(d *binbump.Decoder, w io.Writer) error {
+		return d.Grid().WriteSVG(w)
+	}},
+	"png": {ext: ".png", write: func(d *binbump.Decoder, w io.Writer) error {
+		return d.Grid().WritePNG(w)
+	}},
+	"sixel": {ext: ".six", stdout: true, write: func(d *binbump.Decoder, w io.Writer) error {
+		return d.Grid().WriteSixel(w)
+	}},
+	"iterm2": {ext: ".iterm2", stdout: true, write: func(d *binbump.Decoder, w io.Writer) error {
+		return d.Grid().WriteITerm2(w)
+	}},
+	"kitty": {ext: ".kitty", stdout: true, write: func(d *binbump.Decoder, w io.Writer) error {
+		return d.Grid().WriteKitty(w)
+	}},
+	"json": {ext: ".json", write: func(d *binbump.Decoder, w io.Writer) error {
+		p, err := d.Grid().MarshalJSON()
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(p)
+		return err //nolint:wrapcheck
+	}},
+	"csv": {ext: ".csv", write: func(d *binbump.Decoder, w io.Writer) error {
+		return d.Grid().WriteCSV(w)
+	}},
+	"ascii": {ext: ".asc", write: func(d *binbump.Decoder, w io.Writer) error {
+		return d.Grid().WriteASCII(w)
+	}},
+	"utf8ans": {ext: ".utf8ans", write: func(d *binbump.Decoder, w io.Writer) error {
+		return d.Grid().WriteUTF8ANS(w)
+	}},
+}
+
+// inlineProtocol returns the name of the format of the inline image protocol supported by
+// the terminal, detected using the environment variables set by the terminal, or ansi
+// when the terminal does not support images.
+func inlineProtocol() string {
+	term, program := os.Getenv("TERM"), os.Getenv("TERM_PROGRAM")
+	switch {
+	case term == "xterm-kitty", os.Getenv("KITTY_WINDOW_ID") != "", program == "ghostty":
+		return "kitty"
+	case program == "iTerm.app", program == "WezTerm", os.Getenv("LC_TERMINAL") == "iTerm2":
+		return "iterm2"
+	case strings.HasPrefix(term, "foot"), strings.HasPrefix(term, "mlterm"),
+		term == "xterm-sixel", term == "yaft-256color":
+		return "sixel"
+	}
+	return "ansi"
+}
+
+// formatNames returns the sorted names of the output formats.
+func formatNames() []string {
+	names := make([]string, 0, len(formats)+1)
+	names = append(names, inline)
+	for name := range formats {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// inline is the name of the format that selects the inline image protocol of the terminal.
+const inline = "inline"
+
+// lookupFormat returns the output format of the name, which is case-insensitive
+// and can also be the file extension of the format, such as htm or txt.
+// The inline format is the format returned by [inlineProtocol].
+func lookupFormat(name string) (format, error) {
+	name = strings.ToLower(strings.TrimPrefix(name, "."))
+	if name == inline {
+		name = inlineProtocol()
+	}
+	if f, ok := formats[name]; ok {
+		return f, nil
+	}
+	if name == "htm" {
+		return formats["html"], nil
+	}
+	for _, f := range formats {
+		if f.ext == "."+name {
+			return f, nil
+		}
+	}
+	return format{}, fmt.Errorf("%w: %q, choose from %s", errFormat, name, strings.Join(formatNames(), ", "))
+}
+
+// convert renders the named file in the output format. The output is written to the
+// standard output, or saved with the extension of the format next to the file or in
+// the output directory.
+func (c config) convert(name string, stdout io.Writer) error {
+	d, err := binbump.DecodeFile(name)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+	if c.toStdout() {
+		w := bufio.NewWriter(stdout)
+		if err := c.format.write(d, w); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		return w.Flush() //nolint:wrapcheck
+	}
+	out := strings.TrimSuffix(name, filepath.Ext(name)) + c.format.ext
+	if c.out != "" {
+		out = filepath.Join(c.out, filepath.Base(out))
+	}
+	return binbump.SaveFile(out, func(w io.Writer) error { return c.format.write(d, w) })
+}
+
+// toStdout reports whether the output is written to the standard output,
+// either by the default of the format or when the output directory is a dash.
+func (c config) toStdout() bool {
+	if c.out == "" {
+		return c.format.stdout
+	}
+	return c.out == "-"
+}
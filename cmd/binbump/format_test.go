@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const fixture = "../../testdata/test1.bin"
+
+func TestLookupFormat(t *testing.T) {
+	t.Setenv("TERM", "xterm-kitty")
+	tests := []struct {
+		name string
+		ext  string
+		err  error
+	}{
+		{"html", ".html", nil},
+		{"HTML", ".html", nil},
+		{"htm", ".html", nil},
+		{".txt", ".txt", nil},
+		{"text", ".txt", nil},
+		{"six", ".six", nil},
+		{"asc", ".asc", nil},
+		{"utf8ans", ".utf8ans", nil},
+		{"inline", ".kitty", nil},
+		{"doc", "", errFormat},
+		{"", "", errFormat},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := lookupFormat(tt.name)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("lookupFormat(%q) error = %v, want %v", tt.name, err, tt.err)
+			}
+			if f.ext != tt.ext {
+				t.Errorf("lookupFormat(%q) ext = %q, want %q", tt.name, f.ext, tt.ext)
+			}
+		})
+	}
+}
+
+func TestConvert(t *testing.T) {
+	for name, f := range formats {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			c := config{out: dir, format: f}
+			if err := c.convert(fixture, nil); err != nil {
+				t.Fatal(err)
+			}
+			st, err := os.Stat(filepath.Join(dir, "test1"+f.ext))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if st.Size() == 0 {
+				t.Errorf("%s output is empty", name)
+			}
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(entries) != 1 {
+				t.Errorf("%s output directory has %d files, want 1", name, len(entries))
+			}
+		})
+	}
+}
+
+func TestConvertStdout(t *testing.T) {
+	c := config{out: "-", format: formats["text"]}
+	var b bytes.Buffer
+	if err := c.convert(fixture, &b); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := b.String(), "THIS IS A Φ TEST Φ\n"; got != want {
+		t.Errorf("convert to stdout = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"image"
+	_ "image/gif"  // register the GIF format
+	_ "image/jpeg" // register the JPEG format
+	_ "image/png"  // register the PNG format
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bengarrett/binbump"
+)
+
+// img2bin converts the GIF, JPEG or PNG image files to binary screen dumps,
+// saved with the .bin extension.
+func img2bin(args []string, stderr io.Writer) error {
+	fs := flag.NewFlagSet("binbump img2bin", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	out := fs.String("o", "", "output `directory`, instead of the directory of each file")
+	width := fs.Int("width", 80, "number of `columns` of the screen")
+	pal := binbump.StandardCGA
+	fs.TextVar(&pal, "palette", pal, "`name` of the palette, one of "+strings.Join(binbump.Palettes(), ", "))
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: binbump img2bin [flags] image.png...")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err //nolint:wrapcheck
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errNoFiles
+	}
+	for _, name := range fs.Args() {
+		img, err := decodeImage(name)
+		if err != nil {
+			return err
+		}
+		g := binbump.FromImage(img, *width, pal)
+		bin := strings.TrimSuffix(name, filepath.Ext(name)) + ".bin"
+		if *out != "" {
+			bin = filepath.Join(*out, filepath.Base(bin))
+		}
+		if err := binbump.SaveFile(bin, g.WriteBIN); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeImage opens and decodes the named image file.
+func decodeImage(name string) (image.Image, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("img2bin: %w", err)
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("img2bin %s: %w", name, err)
+	}
+	return img, nil
+}
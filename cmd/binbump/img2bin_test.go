@@ -0,0 +1,47 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestImg2bin(t *testing.T) {
+	dir := t.TempDir()
+	img := image.NewRGBA(image.Rect(0, 0, 40, 40))
+	for y := range 40 {
+		for x := range 40 {
+			img.Set(x, y, color.RGBA{R: uint8(x * 6), G: uint8(y * 6), B: 0xaa, A: 0xff})
+		}
+	}
+	name := filepath.Join(dir, "picture.png")
+	f, err := os.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := png.Encode(f, img); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	out := t.TempDir()
+	if err := img2bin([]string{"-width", "10", "-o", out, name}, io.Discard); err != nil {
+		t.Fatal(err)
+	}
+	st, err := os.Stat(filepath.Join(out, "picture.bin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 10 columns of cells 4 pixels wide and 8 pixels tall, with 2 bytes for each cell
+	if got, want := st.Size(), int64(10*5*2); got != want {
+		t.Errorf("img2bin output is %d bytes, want %d", got, want)
+	}
+	if err := img2bin([]string{filepath.Join(dir, "missing.png")}, io.Discard); err == nil {
+		t.Error("img2bin of a missing file returned no error")
+	}
+}
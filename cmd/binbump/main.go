@@ -0,0 +1,105 @@
+// Command binbump converts binary screen dumps to HTML fragments, and other formats.
+//
+// Usage:
+//
+//	binbump [flags] file.bin...
+//	binbump -watch dir [flags]
+//	binbump view [flags] file.bin...
+//	binbump img2bin [flags] image.png...
+//
+// The -format flag chooses the output, either html, ansi, text, svg, png, sixel, iterm2,
+// kitty, json, csv, utf8ans or ascii. Each file is saved with the same name and the extension
+// of the format, .html, .ansi, .txt, .svg, .png, .six, .iterm2, .kitty, .json, .csv, .utf8ans
+// or .asc, either next to the file or in the output directory. An output directory of "-" writes to
+// the standard output instead, which is the default of the ansi, sixel, iterm2 and kitty
+// formats for display in the terminal.
+// The inline format chooses the kitty, iterm2 or sixel image protocol supported by the terminal,
+// detected from the TERM, TERM_PROGRAM and similar environment variables, or otherwise ansi.
+//
+// The -watch flag monitors a directory and re-converts the binary screen dump files
+// as they are saved, so rendered previews stay live during drawing sessions.
+// It runs until interrupted.
+//
+// The view subcommand displays the files in the terminal as ANSI text, cropped to the
+// width of the terminal, centered when narrower, and paged when taller than the terminal.
+//
+// The img2bin subcommand converts GIF, JPEG and PNG images to binary screen dumps of
+// block and shade characters, saved with the .bin extension.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+)
+
+var errNoFiles = errors.New("no files or directory to watch")
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if err := run(ctx, os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "binbump:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+// config is the command-line configuration.
+type config struct {
+	out      string        // out is the output directory
+	format   format        // format is the output format
+	watch    string        // watch is the directory to monitor
+	interval time.Duration // interval is the time between the scans of the watched directory
+}
+
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	if len(args) > 0 && args[0] == "view" {
+		return view(args[1:], stdout, stderr)
+	}
+	if len(args) > 0 && args[0] == "img2bin" {
+		return img2bin(args[1:], stderr)
+	}
+	var c config
+	name := "html"
+	fs := flag.NewFlagSet("binbump", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&c.out, "o", "", "output `directory`, instead of the directory of each file, or - for the standard output")
+	fs.StringVar(&name, "format", name, "output `format`, one of "+strings.Join(formatNames(), ", "))
+	fs.StringVar(&c.watch, "watch", "", "monitor the `directory` and convert the files as they are saved")
+	fs.DurationVar(&c.interval, "interval", time.Second, "time between the scans of the watched directory")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: binbump [flags] file.bin...")
+		fmt.Fprintln(fs.Output(), "       binbump -watch dir [flags]")
+		fmt.Fprintln(fs.Output(), "       binbump view [flags] file.bin...")
+		fmt.Fprintln(fs.Output(), "       binbump img2bin [flags] image.png...")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err //nolint:wrapcheck
+	}
+	var err error
+	if c.format, err = lookupFormat(name); err != nil {
+		return err
+	}
+	if c.watch != "" {
+		return c.watchDir(ctx, stdout, stderr)
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errNoFiles
+	}
+	for _, name := range fs.Args() {
+		if err := c.convert(name, stdout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"os"
+	"strconv"
+)
+
+// termSize returns the columns and rows of the terminal, using the size reported by the
+// terminal device, the COLUMNS and LINES environment variables, or otherwise 80 by 25.
+func termSize() (int, int) {
+	if cols, rows, ok := deviceSize(); ok {
+		return cols, rows
+	}
+	const columns, lines = 80, 25
+	cols, rows := columns, lines
+	if n, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && n > 0 {
+		cols = n
+	}
+	if n, err := strconv.Atoi(os.Getenv("LINES")); err == nil && n > 0 {
+		rows = n
+	}
+	return cols, rows
+}
@@ -0,0 +1,8 @@
+//go:build !(darwin || dragonfly || freebsd || linux || netbsd || openbsd)
+
+package main
+
+// deviceSize returns false, as the size of the terminal device is not supported.
+func deviceSize() (int, int, bool) {
+	return 0, 0, false
+}
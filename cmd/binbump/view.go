@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bengarrett/binbump"
+)
+
+// view renders the files as ANSI text for display in the terminal, cropped to the width
+// of the terminal and centered when narrower. When the output is taller than the terminal,
+// it is paged with the $PAGER program, or less.
+func view(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("binbump view", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	noPager := fs.Bool("nopager", false, "write to the terminal without paging")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: binbump view [flags] file.bin...")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err //nolint:wrapcheck
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errNoFiles
+	}
+	cols, rows := termSize()
+	var b bytes.Buffer
+	for _, name := range fs.Args() {
+		d, err := binbump.DecodeFile(name)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+		var screen bytes.Buffer
+		if err := d.Grid().WriteANSI(&screen, colorMode()); err != nil {
+			return err //nolint:wrapcheck
+		}
+		b.Write(fit(screen.Bytes(), d.Grid().Width(), cols))
+	}
+	if !*noPager && isTerminal(stdout) && bytes.Count(b.Bytes(), []byte("\n")) > rows {
+		if err := page(&b, stdout, stderr); err == nil {
+			return nil
+		}
+	}
+	_, err := b.WriteTo(stdout)
+	return err //nolint:wrapcheck
+}
+
+// fit returns the lines of the ANSI text of a screen of the width cropped to cols
+// visible characters, or centered when the screen is narrower.
+func fit(screen []byte, width, cols int) []byte {
+	indent := strings.Repeat(" ", max(0, (cols-width)/2))
+	var b bytes.Buffer
+	for line := range bytes.Lines(screen) {
+		b.WriteString(indent)
+		b.Write(crop(bytes.TrimSuffix(line, []byte("\n")), cols))
+		b.WriteByte('\n')
+	}
+	return b.Bytes()
+}
+
+// crop returns the line of ANSI text with at most cols visible characters,
+// keeping the escape sequences so that the colors are reset at the end of the line.
+func crop(line []byte, cols int) []byte {
+	const esc = 0x1b
+	out := make([]byte, 0, len(line))
+	n := 0
+	for i := 0; i < len(line); {
+		if line[i] == esc {
+			end := bytes.IndexByte(line[i:], 'm')
+			if end < 0 {
+				break
+			}
+			out = append(out, line[i:i+end+1]...)
+			i += end + 1
+			continue
+		}
+		_, size := utf8.DecodeRune(line[i:])
+		if n < cols {
+			out = append(out, line[i:i+size]...)
+		}
+		n++
+		i += size
+	}
+	return out
+}
+
+// colorMode returns the color depth supported by the terminal,
+// using the COLORTERM and TERM environment variables.
+func colorMode() binbump.ColorMode {
+	switch ct := os.Getenv("COLORTERM"); {
+	case ct == "truecolor", ct == "24bit":
+		return binbump.TrueColor
+	case strings.Contains(os.Getenv("TERM"), "256color"):
+		return binbump.Color256
+	}
+	return binbump.Color16
+}
+
+// isTerminal reports whether w is a terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	st, err := f.Stat()
+	return err == nil && st.Mode()&os.ModeCharDevice != 0
+}
+
+var errPager = errors.New("no pager")
+
+// page writes the text to the pager program named by the $PAGER environment variable,
+// or less with the raw control characters option.
+func page(text io.Reader, stdout, stderr io.Writer) error {
+	args := strings.Fields(os.Getenv("PAGER"))
+	if len(args) == 0 {
+		args = []string{"less", "-R"}
+	}
+	path, err := exec.LookPath(args[0])
+	if err != nil {
+		return errPager
+	}
+	cmd := exec.Command(path, args[1:]...) //nolint:gosec
+	cmd.Stdin = bufio.NewReader(text)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	return cmd.Run() //nolint:wrapcheck
+}
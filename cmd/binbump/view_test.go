@@ -0,0 +1,62 @@
+package main
+
+import "testing"
+
+func TestCrop(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		cols int
+		want string
+	}{
+		{"narrower", "\x1b[31mHello\x1b[0m", 80, "\x1b[31mHello\x1b[0m"},
+		{"same width", "\x1b[31mHello\x1b[0m", 5, "\x1b[31mHello\x1b[0m"},
+		{"wider", "\x1b[31mHello\x1b[32m, world\x1b[0m", 4, "\x1b[31mHell\x1b[32m\x1b[0m"},
+		{"multibyte", "█▓▒░", 2, "█▓"},
+		{"zero columns", "\x1b[31mHi\x1b[0m", 0, "\x1b[31m\x1b[0m"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(crop([]byte(tt.line), tt.cols)); got != tt.want {
+				t.Errorf("crop(%q, %d) = %q, want %q", tt.line, tt.cols, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFit(t *testing.T) {
+	const screen = "\x1b[31mABCD\x1b[0m\nEFGH\n"
+	tests := []struct {
+		name  string
+		width int
+		cols  int
+		want  string
+	}{
+		{"centered", 4, 10, "   \x1b[31mABCD\x1b[0m\n   EFGH\n"},
+		{"same width", 4, 4, "\x1b[31mABCD\x1b[0m\nEFGH\n"},
+		{"cropped", 4, 2, "\x1b[31mAB\x1b[0m\nEF\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(fit([]byte(screen), tt.width, tt.cols)); got != tt.want {
+				t.Errorf("fit(%d, %d) = %q, want %q", tt.width, tt.cols, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTermSize(t *testing.T) {
+	if _, _, ok := deviceSize(); ok {
+		t.Skip("the size is reported by the terminal device")
+	}
+	t.Setenv("COLUMNS", "100")
+	t.Setenv("LINES", "40")
+	if cols, rows := termSize(); cols != 100 || rows != 40 {
+		t.Errorf("termSize() = %d, %d, want 100, 40", cols, rows)
+	}
+	t.Setenv("COLUMNS", "wide")
+	t.Setenv("LINES", "-1")
+	if cols, rows := termSize(); cols != 80 || rows != 25 {
+		t.Errorf("termSize() = %d, %d, want 80, 25", cols, rows)
+	}
+}
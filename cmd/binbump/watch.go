@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// stamp identifies a version of a saved file.
+type stamp struct {
+	size    int64
+	modTime time.Time
+}
+
+// watchDir converts the binary screen dump files of the watched directory whenever they
+// are created or saved, until the context is done. The directory is scanned at each
+// interval, and a changed file is only converted once its size and modification time
+// are unchanged between two scans, so partially saved files are skipped.
+func (c config) watchDir(ctx context.Context, stdout, stderr io.Writer) error {
+	done := map[string]stamp{}    // the converted versions of the files
+	pending := map[string]stamp{} // the changed versions waiting for the next scan
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	fmt.Fprintf(stderr, "watching %s, press Ctrl+C to stop\n", c.watch)
+	for {
+		files, err := scan(c.watch)
+		if err != nil {
+			return err
+		}
+		for name, st := range files {
+			if done[name] == st {
+				continue
+			}
+			if pending[name] != st {
+				pending[name] = st
+				continue
+			}
+			delete(pending, name)
+			done[name] = st
+			if err := c.convert(name, stdout); err != nil {
+				fmt.Fprintln(stderr, err)
+				continue
+			}
+			fmt.Fprintln(stderr, "converted", name)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// scan returns the stamps of the binary screen dump files in the directory.
+func scan(dir string) (map[string]stamp, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("watch: %w", err)
+	}
+	files := make(map[string]stamp, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || !strings.EqualFold(filepath.Ext(e.Name()), ".bin") {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue // the file was removed after the read
+		}
+		files[filepath.Join(dir, e.Name())] = stamp{size: info.Size(), modTime: info.ModTime()}
+	}
+	return files, nil
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWatchDir(t *testing.T) {
+	const interval = 200 * time.Millisecond
+	dir := t.TempDir()
+	data, err := os.ReadFile(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bin := filepath.Join(dir, "art.bin")
+	if err := os.WriteFile(bin, data[:160], 0o644); err != nil {
+		t.Fatal(err)
+	}
+	out := filepath.Join(dir, "art.txt")
+	exists := func() bool {
+		_, err := os.Stat(out)
+		return err == nil
+	}
+	c := config{format: formats["text"], watch: dir, interval: interval}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- c.watchDir(ctx, io.Discard, io.Discard) }()
+	defer func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Error(err)
+		}
+	}()
+
+	// the file is still being saved, so it changes before the next scan
+	time.Sleep(interval / 4)
+	if exists() {
+		t.Fatal("the file was converted after the first scan")
+	}
+	if err := os.WriteFile(bin, data[:320], 0o644); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(interval + interval/2)
+	if exists() {
+		t.Fatal("the file was converted while it was changing")
+	}
+	// the file is unchanged between the scans, so it is converted
+	for deadline := time.Now().Add(10 * interval); !exists(); time.Sleep(interval / 4) {
+		if time.Now().After(deadline) {
+			t.Fatal("the file was not converted once it was stable")
+		}
+	}
+}
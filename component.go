@@ -0,0 +1,212 @@
+package binbump
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// component is the script of the <bin-bump> custom element, where the
+// TABLES placeholder is replaced with the charsets and palettes.
+const component = `// <bin-bump> renders binary screen dumps, generated by binbump.
+(function () {
+  "use strict";
+  const tables = TABLES;
+  function text(data, start, end) {
+    return String.fromCharCode.apply(null, data.subarray(start, end));
+  }
+  // trim removes any SAUCE record, comments and end-of-file character.
+  function trim(data) {
+    const record = 128, comment = 64;
+    let cut = data.length - record;
+    if (cut < 0 || text(data, cut, cut + 7) !== "SAUCE00") {
+      return data;
+    }
+    const n = data[cut + 104];
+    const start = cut - 5 - n * comment;
+    if (n > 0 && start >= 0 && text(data, start, start + 5) === "COMNT") {
+      cut = start;
+    }
+    if (cut > 0 && data[cut - 1] === 0x1a) {
+      cut--;
+    }
+    return data.subarray(0, cut);
+  }
+  class BinBump extends HTMLElement {
+    static get observedAttributes() {
+      return ["src", "data", "width", "palette", "colors", "charset"];
+    }
+    constructor() {
+      super();
+      this.attachShadow({mode: "open"});
+    }
+    connectedCallback() {
+      this.load();
+    }
+    attributeChangedCallback() {
+      if (this.isConnected) {
+        this.load();
+      }
+    }
+    async load() {
+      let data;
+      try {
+        if (this.hasAttribute("src")) {
+          const res = await fetch(this.getAttribute("src"));
+          if (!res.ok) {
+            throw new Error(res.status + " " + res.statusText);
+          }
+          data = new Uint8Array(await res.arrayBuffer());
+        } else {
+          data = Uint8Array.from(atob(this.getAttribute("data") || ""), function (c) {
+            return c.charCodeAt(0);
+          });
+        }
+      } catch (err) {
+        this.dispatchEvent(new CustomEvent("error", {detail: err}));
+        return;
+      }
+      this.render(trim(data));
+    }
+    render(data) {
+      const width = parseInt(this.getAttribute("width"), 10) || 160;
+      const chars = tables.charsets[this.getAttribute("charset")] || tables.charsets.cp437;
+      let colors = tables.palettes[this.getAttribute("palette")] || tables.palettes.cga;
+      const custom = (this.getAttribute("colors") || "").split(",");
+      if (custom.length === 16 && custom.every(function (c) { return /^[0-9a-f]{3}([0-9a-f]{3})?$/i.test(c); })) {
+        colors = custom;
+      }
+      const pre = document.createElement("pre");
+      pre.style.cssText = "margin:0;font-family:monospace;line-height:1";
+      let attr = -1, chunk = "";
+      const flush = function () {
+        if (chunk === "") {
+          return;
+        }
+        const span = document.createElement("span");
+        span.style.color = "#" + colors[attr & 0x0f];
+        span.style.backgroundColor = "#" + colors[(attr >> 4) & 0x07];
+        span.textContent = chunk;
+        pre.append(span);
+        chunk = "";
+      };
+      for (let i = 0; i + 1 < data.length; i += 2) {
+        if (i > 0 && (i / 2) % width === 0) {
+          flush();
+          pre.append("\n");
+        }
+        if ((data[i + 1] & 0x7f) !== (attr & 0x7f)) {
+          flush();
+          attr = data[i + 1];
+        }
+        chunk += chars[data[i]];
+      }
+      flush();
+      this.shadowRoot.replaceChildren(pre);
+    }
+  }
+  if (!customElements.get("bin-bump")) {
+    customElements.define("bin-bump", BinBump);
+  }
+}());
+`
+
+// WriteComponent writes to w the JavaScript of the <bin-bump> custom element, so sites
+// can render binary screen dumps in the browser instead of on the server.
+// The script includes the charsets of [Charsets] and the colorsets of the [Palettes],
+// except for the monochrome display adapter.
+//
+// The element renders the BIN data of the src URL, or of the base64 data attribute, where
+// any SAUCE metadata is removed. The width attribute is the number of columns, which by
+// default is 160, the palette and charset attributes are the names of a palette and charset,
+// and the colors attribute is a custom colorset of 16 comma-separated hexadecimal triplets.
+//
+//	<script src="bin-bump.js"></script>
+//	<bin-bump src="art.bin" width="80" palette="revised-cga"></bin-bump>
+func WriteComponent(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	type tables struct {
+		Charsets map[string][]string `json:"charsets"`
+		Palettes map[string][]string `json:"palettes"`
+	}
+	t := tables{Charsets: map[string][]string{}, Palettes: map[string][]string{}}
+	for name, cs := range charsets {
+		chars := make([]string, 256) //nolint:mnd
+		for i := range chars {
+			chars[i] = string(cs.DecodeByte(byte(i)))
+		}
+		t.Charsets[name] = chars
+	}
+	for _, name := range Palettes() {
+		pal, err := PaletteByName(name)
+		if err != nil || pal == MDA {
+			continue
+		}
+		g := &Grid{}
+		g.setPalette(pal)
+		t.Palettes[name] = g.colors.hexes()
+	}
+	js, err := json.Marshal(t)
+	if err != nil {
+		return fmt.Errorf("write component: %w", err)
+	}
+	out := bufio.NewWriter(w)
+	out.WriteString(strings.Replace(component, "TABLES", string(js), 1))
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("write component flush: %w", err)
+	}
+	return nil
+}
+
+// WriteElement writes to w a <bin-bump> custom element of the grid, with the cells as
+// a base64 BIN payload, and the width, charset and colors of the grid as attributes.
+// The element is rendered by the script of [WriteComponent].
+func (g *Grid) WriteElement(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	var bin strings.Builder
+	enc := base64.NewEncoder(base64.StdEncoding, &bin)
+	if err := g.WriteBIN(enc); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("write element: %w", err)
+	}
+	out := bufio.NewWriter(w)
+	out.WriteString(`<bin-bump width="` + strconv.Itoa(g.width) + `" charset="` +
+		html.EscapeString(charsetName(g.charset)) + `" colors="` +
+		strings.Join(g.colors.hexes(), ",") + `" data="` + bin.String() + `"></bin-bump>`)
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("write element flush: %w", err)
+	}
+	return nil
+}
+
+// hexes returns the colorset as six-digit hexadecimal values.
+func (c Colors) hexes() []string {
+	s := make([]string, len(c))
+	for i, col := range c {
+		s[i] = col.hex()
+	}
+	return s
+}
+
+// charsetName returns the name of the charset available to [CharsetByName], or cp437.
+func charsetName(cs *charmap.Charmap) string {
+	for name, c := range charsets {
+		if c == cs {
+			return name
+		}
+	}
+	return "cp437"
+}
@@ -0,0 +1,31 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleWriteComponent() {
+	var buf bytes.Buffer
+	if err := binbump.WriteComponent(&buf); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(strings.Contains(buf.String(), `customElements.define("bin-bump"`))
+	// Output: true
+}
+
+func ExampleGrid_WriteElement() {
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.Read(strings.NewReader("H\x07i\x1e")); err != nil {
+		log.Fatal(err)
+	}
+	if err := d.Grid().WriteElement(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+	// Output: <bin-bump width="2" charset="cp437" colors="000000,0000aa,00aa00,00aaaa,aa0000,aa00aa,aa5500,aaaaaa,555555,5555ff,55ff55,55ffff,ff5555,ff55ff,ffff55,ffffff" data="SAdpHg=="></bin-bump>
+}
@@ -0,0 +1,164 @@
+package binbump
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// String returns the registered name of the palette.
+func (p Palette) String() string {
+	if name, ok := p.name(); ok {
+		return name
+	}
+	return "Palette(" + strconv.FormatUint(uint64(p), 10) + ")"
+}
+
+// name returns the registered name of the palette, or false if it is not registered.
+func (p Palette) name() (string, bool) {
+	palettes.RLock()
+	defer palettes.RUnlock()
+	for name, pal := range palettes.names {
+		if pal == p {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// MarshalText encodes the palette as its registered name.
+// A palette that is not registered returns [ErrPalette].
+func (p Palette) MarshalText() ([]byte, error) {
+	name, ok := p.name()
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrPalette, p)
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText decodes the palette from a name available to [PaletteByName].
+func (p *Palette) UnmarshalText(text []byte) error {
+	pal, err := PaletteByName(string(text))
+	if err != nil {
+		return err
+	}
+	*p = pal
+	return nil
+}
+
+// UnmarshalText decodes the color using [ParseColor], so that a malformed color
+// in a configuration file returns [ErrColor] instead of emitting broken CSS.
+func (c *Color) UnmarshalText(text []byte) error {
+	col, err := ParseColor(string(text))
+	if err != nil {
+		return err
+	}
+	*c = col
+	return nil
+}
+
+// separators are the names of the row separators.
+//
+//nolint:gochecknoglobals
+var separators = [...]string{Newline: "newline", LineBreak: "br", RowDiv: "div", NoSeparator: "none"}
+
+// MarshalText encodes the row separator as a name, either newline, br, div or none.
+func (s RowSeparator) MarshalText() ([]byte, error) {
+	if int(s) >= len(separators) {
+		return nil, fmt.Errorf("%w: %d", ErrSeparator, s)
+	}
+	return []byte(separators[s]), nil
+}
+
+// UnmarshalText decodes the row separator from a name, either newline, br, div or none.
+func (s *RowSeparator) UnmarshalText(text []byte) error {
+	for i, name := range separators {
+		if strings.EqualFold(string(text), name) {
+			*s = RowSeparator(i) //nolint:gosec
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %q", ErrSeparator, text)
+}
+
+// Options are the serializable settings of a conversion, so that they can be stored
+// in configuration files as JSON and replayed deterministically.
+// The fields match the arguments of [NewDecoder] and the exported fields of [Decoder].
+type Options struct {
+	Width         int               `json:"width,omitempty"`
+	MaxRows       int               `json:"maxRows,omitempty"`
+	Palette       Palette           `json:"palette"`
+	Colors        *Colors           `json:"colors,omitempty"`  // Colors replaces the colorset of the palette.
+	Charset       string            `json:"charset,omitempty"` // Charset is a name available to [CharsetByName].
+	Debug         bool              `json:"debug,omitempty"`
+	Optimize      bool              `json:"optimize,omitempty"`
+	ASCII         bool              `json:"ascii,omitempty"`
+	Minify        bool              `json:"minify,omitempty"`
+	Links         bool              `json:"links,omitempty"`
+	Trace         bool              `json:"trace,omitempty"`
+	Indent        string            `json:"indent,omitempty"`
+	Separator     RowSeparator      `json:"separator"`
+	RowID         string            `json:"rowId,omitempty"`
+	RowClass      string            `json:"rowClass,omitempty"`
+	ClassPrefix   string            `json:"classPrefix,omitempty"`
+	LetterSpacing string            `json:"letterSpacing,omitempty"`
+	LineHeight    string            `json:"lineHeight,omitempty"`
+	FontFamily    string            `json:"fontFamily,omitempty"`
+	Background    Color             `json:"background,omitempty"`
+	Space         rune              `json:"space,omitempty"`
+	Element       string            `json:"element,omitempty"`
+	ElementID     string            `json:"elementId,omitempty"`
+	ElementClass  []string          `json:"elementClass,omitempty"`
+	ElementAttrs  map[string]string `json:"elementAttrs,omitempty"`
+	ChunkRows     int               `json:"chunkRows,omitempty"`
+	Workers       int               `json:"workers,omitempty"`
+	StripBlink    bool              `json:"stripBlink,omitempty"`   // StripBlink uses [WithStripBlink].
+	ReverseVideo  bool              `json:"reverseVideo,omitempty"` // ReverseVideo uses [WithReverseVideo].
+}
+
+// NewDecoder creates a Decoder using the options.
+// An unknown charset name returns [ErrCharset].
+func (o Options) NewDecoder(opts ...Option) (*Decoder, error) {
+	var cs *charmap.Charmap
+	if o.Charset != "" {
+		var err error
+		if cs, err = CharsetByName(o.Charset); err != nil {
+			return nil, err
+		}
+	}
+	if o.StripBlink {
+		opts = append([]Option{WithStripBlink()}, opts...)
+	}
+	if o.ReverseVideo {
+		opts = append([]Option{WithReverseVideo()}, opts...)
+	}
+	d := NewDecoder(o.Width, o.MaxRows, o.Palette, cs, opts...)
+	if o.Colors != nil {
+		d.grid.SetColors(*o.Colors)
+	}
+	d.Debug = o.Debug
+	d.Optimize = o.Optimize
+	d.ASCII = o.ASCII
+	d.Minify = o.Minify
+	d.Links = o.Links
+	d.Trace = o.Trace
+	d.Indent = o.Indent
+	d.Separator = o.Separator
+	d.RowID = o.RowID
+	d.RowClass = o.RowClass
+	d.ClassPrefix = o.ClassPrefix
+	d.LetterSpacing = o.LetterSpacing
+	d.LineHeight = o.LineHeight
+	d.FontFamily = o.FontFamily
+	d.Background = o.Background
+	d.Space = o.Space
+	d.Element = o.Element
+	d.ElementID = o.ElementID
+	d.ElementClass = o.ElementClass
+	d.ElementAttrs = o.ElementAttrs
+	d.ChunkRows = o.ChunkRows
+	d.Workers = o.Workers
+	return d, nil
+}
@@ -0,0 +1,57 @@
+package binbump_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleOptions() {
+	config := []byte(`{
+		"width": 2,
+		"palette": "tandy",
+		"colors": ["000","00a","0a0","0a0","a00","a0a","aa0","#AAAAAA",
+			"555","55f","5f5","5ff","f55","f5f","ff5","fff"],
+		"charset": "cp866",
+		"separator": "br"
+	}`)
+	var o binbump.Options
+	if err := json.Unmarshal(config, &o); err != nil {
+		panic(err)
+	}
+	d, err := o.NewDecoder()
+	if err != nil {
+		panic(err)
+	}
+	if err := d.Read(bytes.NewReader([]byte{0x8f, 0x07, 0x42, 0x07})); err != nil {
+		panic(err)
+	}
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	b, _ := json.Marshal(binbump.Options{Palette: binbump.RevisedCGA, Optimize: true})
+	fmt.Println(string(b))
+	// Output: <div><span style="color:#aaaaaa;background-color:#000;">ПB</span><br></div>
+	// {"palette":"revised-cga","optimize":true,"separator":"newline"}
+}
+
+func ExampleColor_UnmarshalText() {
+	var c binbump.Colors
+	err := json.Unmarshal([]byte(`["000","00a","0a0","0aa","a00","a0a","a50","aaa",
+		"555","55f","5f5","5ff","f55","f5f","ff5","#ffff"]`), &c)
+	fmt.Println(err)
+	// Output: color is not a 3 or 6 digit hexadecimal triplet: "#ffff"
+}
+
+func ExamplePalette_MarshalText() {
+	b, err := binbump.Tandy.MarshalText()
+	fmt.Println(string(b), err)
+	_, err = binbump.Palette(99).MarshalText()
+	fmt.Println(err)
+	// Output: tandy <nil>
+	// palette name is unknown: 99
+}
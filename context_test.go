@@ -0,0 +1,31 @@
+package binbump_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleBufferContext() {
+	data := []byte{0x41, 0x00, 0x42, 0x08}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	r := bytes.NewReader(data)
+	buf, _ := binbump.BufferContext(ctx, r, 0, 0, binbump.StandardCGA, nil)
+	fmt.Printf("%q", buf.String())
+	// Output: "<div><span style=\"color:#000;background-color:#000;\">A</span><span style=\"color:#555;background-color:#000;\">B</span>\n</div>"
+}
+
+func ExampleDecoder_ReadContext() {
+	data := bytes.Repeat([]byte{0x41, 0x07}, 80*25)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil)
+	err := d.ReadContext(ctx, bytes.NewReader(data))
+	fmt.Println(errors.Is(err, context.Canceled))
+	// Output: true
+}
@@ -0,0 +1,81 @@
+package binbump
+
+import (
+	"cmp"
+	"slices"
+)
+
+// MinContrast is the minimum contrast ratio of normal sized text
+// required by the WCAG 2 level AA success criterion.
+const MinContrast = 4.5
+
+// Contrast is the WCAG contrast ratio of a foreground and background color pair used by a grid.
+type Contrast struct {
+	FG    uint8   // FG is the foreground color code.
+	BG    uint8   // BG is the background color code.
+	Ratio float64 // Ratio is the contrast ratio, between 1 and 21.
+	Cells int     // Cells is the number of cells using the pair.
+	Text  int     // Text is the number of cells using the pair that contain readable characters.
+}
+
+// Readable reports whether the ratio meets the [MinContrast] for text.
+func (c Contrast) Readable() bool {
+	return c.Ratio >= MinContrast
+}
+
+// Contrast returns the WCAG contrast ratios of every foreground and background color
+// pair used by the grid, sorted from the least to the most readable.
+//
+// Pairs that are not [Contrast.Readable] but have Text cells are likely to be illegible
+// when the artwork is repurposed as website content, while pairs without Text cells
+// are only used for spaces, blocks and box-drawing characters.
+func (g *Grid) Contrast() []Contrast {
+	type pair struct{ fg, bg uint8 }
+	used := map[pair]*Contrast{}
+	for _, row := range g.rows {
+		for _, c := range row {
+			fg, bg := g.attrColors(c)
+			p := pair{fg, bg}
+			if used[p] == nil {
+				used[p] = &Contrast{
+					FG:    fg,
+					BG:    bg,
+					Ratio: contrastRatio(g.colors[fg], g.colors[bg]),
+				}
+			}
+			used[p].Cells++
+			if !graphic(g.rune(c)) {
+				used[p].Text++
+			}
+		}
+	}
+	report := make([]Contrast, 0, len(used))
+	for _, c := range used {
+		report = append(report, *c)
+	}
+	slices.SortFunc(report, func(a, b Contrast) int {
+		return cmp.Or(
+			cmp.Compare(a.Ratio, b.Ratio),
+			cmp.Compare(a.FG, b.FG),
+			cmp.Compare(a.BG, b.BG))
+	})
+	return report
+}
+
+// contrastRatio returns the WCAG contrast ratio of the two colors.
+func contrastRatio(a, b Color) float64 {
+	const flare = 0.05
+	la, lb := luminance(a), luminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + flare) / (lb + flare)
+}
+
+// luminance returns the WCAG relative luminance of the color, between 0 and 1.
+//
+//nolint:mnd
+func luminance(c Color) float64 {
+	r, g, b := c.RGB()
+	return 0.2126*linear(r) + 0.7152*linear(g) + 0.0722*linear(b)
+}
@@ -0,0 +1,24 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_Contrast() {
+	// "Hi" blue on red, "!" gray on black and a red block
+	data := []byte{0x48, 0x41, 0x69, 0x41, 0x21, 0x07, 0xdb, 0x04}
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	for _, c := range d.Grid().Contrast() {
+		fmt.Printf("%d on %d, %.2f:1, readable %t, %d text cells\n",
+			c.FG, c.BG, c.Ratio, c.Readable(), c.Text)
+	}
+	// Output: 1 on 4, 1.71:1, readable false, 2 text cells
+	// 4 on 0, 2.71:1, readable false, 0 text cells
+	// 7 on 0, 9.04:1, readable true, 1 text cells
+}
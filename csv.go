@@ -0,0 +1,41 @@
+package binbump
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// WriteCSV writes to w every cell of the grid as a CSV record, following a header of
+// row,col,char,codepoint,fg,bg,blink, for the analysis of screens in spreadsheets
+// and data frames. The row and col are zero-based, the char is the decoded character
+// and the codepoint is its decimal Unicode value. The fg and bg are the color codes
+// and blink is true or false.
+func (g *Grid) WriteCSV(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	out := csv.NewWriter(w)
+	if err := out.Write([]string{"row", "col", "char", "codepoint", "fg", "bg", "blink"}); err != nil {
+		return fmt.Errorf("write csv: %w", err)
+	}
+	for y, row := range g.rows {
+		for x, c := range row {
+			r := g.rune(c)
+			fg, bg := c.Colors()
+			rec := []string{
+				strconv.Itoa(y), strconv.Itoa(x), string(r), strconv.Itoa(int(r)),
+				strconv.Itoa(int(fg)), strconv.Itoa(int(bg)), strconv.FormatBool(c.Blink()),
+			}
+			if err := out.Write(rec); err != nil {
+				return fmt.Errorf("write csv: %w", err)
+			}
+		}
+	}
+	out.Flush()
+	if err := out.Error(); err != nil {
+		return fmt.Errorf("write csv flush: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package binbump_test
+
+import (
+	"log"
+	"os"
+	"strings"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_WriteCSV() {
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.Read(strings.NewReader("H\x07,\x1e\xdb\x8c")); err != nil {
+		log.Fatal(err)
+	}
+	if err := d.Grid().WriteCSV(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+	// Output: row,col,char,codepoint,fg,bg,blink
+	// 0,0,H,72,7,0,false
+	// 0,1,",",44,14,1,false
+	// 1,0,█,9608,12,0,true
+}
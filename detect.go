@@ -0,0 +1,192 @@
+package binbump
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"regexp"
+
+	"github.com/bengarrett/binbump/sauce"
+)
+
+// Format is a text mode art file format found by [DetectFormat].
+type Format uint
+
+const (
+	UnknownFormat Format = iota // UnknownFormat is empty or unrecognized data.
+	BIN                         // BIN is a raw binary screen dump of character and attribute pairs.
+	XBin                        // XBin is an eXtended BIN file with a header, palette and font.
+	ANSI                        // ANSI is text with ANSI escape sequences.
+	ADF                         // ADF is an ArtWorx file, a binary screen dump with a palette and font.
+	IDF                         // IDF is an iCE Draw file of compressed character and attribute pairs.
+	PCBoard                     // PCBoard is text with @X color codes of the PCBoard BBS.
+	PlainText                   // PlainText is text without any color codes.
+)
+
+// formatNames are the names of the formats.
+//
+//nolint:gochecknoglobals
+var formatNames = [...]string{
+	UnknownFormat: "unknown", BIN: "BIN", XBin: "XBin", ANSI: "ANSI",
+	ADF: "ADF", IDF: "IDF", PCBoard: "PCBoard", PlainText: "text",
+}
+
+func (f Format) String() string {
+	if int(f) >= len(formatNames) {
+		return fmt.Sprintf("Format(%d)", uint(f))
+	}
+	return formatNames[f]
+}
+
+// pcboardCode matches a PCBoard @X color code.
+//
+//nolint:gochecknoglobals
+var pcboardCode = regexp.MustCompile(`@X[0-9A-Fa-f]{2}`)
+
+// DetectFormat returns the likely format of the text mode art read from r,
+// so batch tools can route a directory of mixed files to the right decoder.
+//
+// The XBin and IDF files are found by their signatures, followed by the data type of
+// any SAUCE record. Otherwise, the ADF palette header, ANSI escape sequences and PCBoard
+// color codes are looked for in the start of the data, where data of only printable
+// characters and line breaks is plain text, and any other data is a BIN.
+// Empty data returns UnknownFormat.
+//
+// If r has a Size or Stat method, such as [bytes.Reader] and [os.File], only the start
+// and end of the data are read, otherwise r is read until io.EOF.
+func DetectFormat(r io.ReaderAt) (Format, error) {
+	if r == nil {
+		return UnknownFormat, ErrReader
+	}
+	const window = 4096
+	head, tail, size, err := sniff(r, window)
+	if err != nil {
+		return UnknownFormat, fmt.Errorf("detect format: %w", err)
+	}
+	if size == 0 {
+		return UnknownFormat, nil
+	}
+	switch {
+	case bytes.HasPrefix(head, []byte("XBIN\x1a")):
+		return XBin, nil
+	case bytes.HasPrefix(head, []byte("\x041.")):
+		return IDF, nil
+	}
+	if rec, err := sauce.Decode(tail); err == nil {
+		if f := sauceFormat(rec); f != UnknownFormat {
+			return f, nil
+		}
+	}
+	const adfHeader = 1 + 192 + 4096
+	if size >= adfHeader && adfPalette(head) {
+		return ADF, nil
+	}
+	head = TrimMetadata(head)
+	switch {
+	case bytes.Contains(head, []byte("\x1b[")):
+		return ANSI, nil
+	case pcboardCode.Match(head):
+		return PCBoard, nil
+	case plainText(head):
+		return PlainText, nil
+	}
+	return BIN, nil
+}
+
+// sniff returns up to n bytes from the start of r and a SAUCE record sized tail,
+// and the size of the data.
+func sniff(r io.ReaderAt, n int64) ([]byte, []byte, int64, error) {
+	size := int64(-1)
+	switch v := r.(type) {
+	case interface{ Size() int64 }:
+		size = v.Size()
+	case interface{ Stat() (fs.FileInfo, error) }:
+		if fi, err := v.Stat(); err == nil && fi.Mode().IsRegular() {
+			size = fi.Size()
+		}
+	}
+	if size < 0 {
+		data, err := io.ReadAll(io.NewSectionReader(r, 0, math.MaxInt64))
+		if err != nil {
+			return nil, nil, 0, err //nolint:wrapcheck
+		}
+		return data[:min(int64(len(data)), n)], data, int64(len(data)), nil
+	}
+	read := func(off, n int64) ([]byte, error) {
+		p := make([]byte, n)
+		if got, err := r.ReadAt(p, off); err != nil && (!errors.Is(err, io.EOF) || int64(got) < n) {
+			return nil, err //nolint:wrapcheck
+		}
+		return p, nil
+	}
+	head, err := read(0, min(size, n))
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	tail, err := read(max(size-sauce.RecordSize, 0), min(size, sauce.RecordSize))
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return head, tail, size, nil
+}
+
+// sauceFormat returns the format of the SAUCE record data type,
+// or UnknownFormat when it is not a text mode art format.
+func sauceFormat(rec sauce.Record) Format {
+	const ansimation, pcboard = 2, 4
+	switch rec.DataType {
+	case sauce.BinaryText:
+		return BIN
+	case sauce.XBin:
+		return XBin
+	case sauce.Character:
+		switch rec.FileType {
+		case sauce.ASCII:
+			return PlainText
+		case sauce.ANSi, ansimation:
+			return ANSI
+		case pcboard:
+			return PCBoard
+		}
+	}
+	return UnknownFormat
+}
+
+// adfPalette reports whether the data starts with the version and the 64 color
+// palette of an ArtWorx file, where each red, green and blue value is between 0 and 63.
+func adfPalette(data []byte) bool {
+	const version, palette, maxValue = 1, 192, 63
+	if len(data) < 1+palette || data[0] != version {
+		return false
+	}
+	for _, b := range data[1 : 1+palette] {
+		if b > maxValue {
+			return false
+		}
+	}
+	return true
+}
+
+// plainText reports whether the data only contains printable characters and
+// line breaks, and is not the character and attribute pairs of a BIN,
+// where every attribute is the same printable byte.
+func plainText(data []byte) bool {
+	if bytes.IndexFunc(data, func(r rune) bool {
+		return r < ' ' && !bytes.ContainsRune([]byte("\t\n\f\r\x1a"), r)
+	}) >= 0 {
+		return false
+	}
+	const pair, minPairs = 2, 4
+	if len(data) < pair*minPairs {
+		return true
+	}
+	for i := 1; i < len(data); i += pair {
+		if data[i] != data[1] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/bengarrett/binbump"
+	"github.com/bengarrett/binbump/sauce"
+)
+
+func ExampleDetectFormat() {
+	files := []string{
+		"\x1b[1;33mHello\x1b[0m\r\n",
+		"@X0EHello@X07\r\n",
+		"Hello, world\r\n",
+		"XBIN\x1a\x50\x00\x19\x00\x10\x00",
+		"H\x07e\x07l\x07l\x07o\x07",
+	}
+	for _, s := range files {
+		f, err := binbump.DetectFormat(strings.NewReader(s))
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(f)
+	}
+	// Output: ANSI
+	// PCBoard
+	// text
+	// XBin
+	// BIN
+}
+
+func ExampleDetectFormat_sauce() {
+	// green text on black is printable, but the SAUCE record describes a BIN
+	var b bytes.Buffer
+	b.WriteString("H\x0ae\x0al\x0al\x0ao\x0a")
+	if err := sauce.Append(&b, sauce.Bin(80)); err != nil {
+		panic(err)
+	}
+	f, err := binbump.DetectFormat(bytes.NewReader(b.Bytes()))
+	fmt.Println(f, err)
+	// Output: BIN <nil>
+}
@@ -0,0 +1,270 @@
+package binbump
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// document is the template of the HTML document of [Decoder.WriteDocument].
+//
+//nolint:gochecknoglobals
+var document = template.Must(template.New("document").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+{{- range .Meta}}
+<meta name="{{.Name}}" content="{{.Content}}">
+{{- end}}
+{{- with .JSONLD}}
+<script type="application/ld+json">{{.}}</script>
+{{- end}}
+<style>
+{{- with .FontFace}}
+@font-face{ {{- .}}}
+{{- end}}
+body{background:#000;margin:0}
+{{- if .Caption}}
+figure{margin:0}
+figcaption{color:#aaa;font-family:sans-serif;padding:.5em}
+{{- end}}
+pre{margin:0;{{.Font}}}
+{{- with .Fit}}
+body{container-type:inline-size}
+pre{ {{- .}}}
+{{- end}}
+</style>
+</head>
+<body>
+{{- if .Caption}}
+<figure>
+<pre>{{.Fragment}}</pre>
+<figcaption>
+{{- with .Caption}}{{if .Title}}<cite>{{.Title}}</cite>{{end}}
+{{- if .Author}} by {{.Author}}{{end}}{{if .Group}} of {{.Group}}{{end}}{{if .Date}}, <time>{{.Date}}</time>{{end}}
+{{- range .Comments}}<br>{{.}}{{end}}{{end -}}
+</figcaption>
+</figure>
+{{- else}}
+<pre>{{.Fragment}}</pre>
+{{- end}}
+</body>
+</html>
+`))
+
+// documentFont is the font family name of an embedded font.
+const documentFont = "binbump"
+
+// metaTag is a <meta> element of a document.
+type metaTag struct {
+	Name    string
+	Content string
+}
+
+// caption is the <figcaption> block of a document.
+type caption struct {
+	Title    string
+	Author   string
+	Group    string
+	Date     string
+	Comments []string
+}
+
+// WriteDocument writes to w a complete HTML document of the decoded screen, with the
+// HTML fragment of [Decoder.Write] in a <pre> element.
+//
+// The SAUCE metadata of the file is surfaced in the ways set by [WithMetadata],
+// and the SAUCE title is used as the title of the document.
+//
+// When a font is set by [WithFont] or [Grid.SetFont], it is converted by [Font.WOFF2] and
+// embedded in the document with a CSS @font-face rule, and the font size and line height
+// are set to the glyph height, so the text uses the glyph shapes and letter spacing of the
+// font. Otherwise the document uses the monospace font of the browser.
+// The LetterSpacing, LineHeight and FontFamily fields replace these font settings.
+func (d *Decoder) WriteDocument(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	var frag bytes.Buffer
+	if err := d.Write(&frag); err != nil {
+		return err
+	}
+	data := struct {
+		Title    string
+		Meta     []metaTag
+		JSONLD   template.JS
+		Caption  *caption
+		FontFace template.CSS
+		Font     template.CSS
+		Fit      template.CSS
+		Fragment template.HTML
+	}{
+		Title:    "binbump",
+		Fragment: template.HTML(frag.String()), //nolint:gosec
+	}
+	face, err := d.fontFace()
+	if err != nil {
+		return fmt.Errorf("write document: %w", err)
+	}
+	data.FontFace = face
+	data.Font = template.CSS(d.documentFont())
+	if d.responsive {
+		data.Fit = template.CSS(d.documentFit())
+	}
+	if r := d.record; r != nil {
+		if r.Title != "" {
+			data.Title = r.Title
+		}
+		c := caption{Title: r.Title, Author: r.Author, Group: r.Group, Comments: r.Comments}
+		if !r.Date.IsZero() {
+			c.Date = r.Date.Format(time.DateOnly)
+		}
+		if d.metadata&MetaTags != 0 {
+			data.Meta = c.metaTags()
+		}
+		if d.metadata&Caption != 0 {
+			data.Caption = &c
+		}
+		if d.metadata&JSONLD != 0 {
+			js, err := c.jsonLD()
+			if err != nil {
+				return fmt.Errorf("write document: %w", err)
+			}
+			data.JSONLD = js
+		}
+	}
+	out := bufio.NewWriter(w)
+	if err := document.Execute(out, data); err != nil {
+		return fmt.Errorf("write document: %w", err)
+	}
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("write document flush: %w", err)
+	}
+	return nil
+}
+
+// fontFace returns the CSS declarations of the @font-face rule of the font of the grid
+// converted by [Font.WOFF2], or an empty string when no font is set.
+func (d *Decoder) fontFace() (template.CSS, error) {
+	f := d.grid.renderFont()
+	if f == nil {
+		return "", nil
+	}
+	woff, err := f.WOFF2(documentFont, d.grid.charset)
+	if err != nil {
+		return "", err
+	}
+	return template.CSS("font-family:" + documentFont +
+		";src:url(data:font/woff2;base64," + base64.StdEncoding.EncodeToString(woff) + `) format("woff2")`), nil
+}
+
+// documentFont returns the CSS declarations of the font of the <pre> element,
+// using the embedded font, and the LetterSpacing, LineHeight and FontFamily fields.
+func (d *Decoder) documentFont() string {
+	family, size, lineHeight := "monospace", "", "1"
+	if f := d.grid.renderFont(); f != nil {
+		family = documentFont + ",monospace"
+		size = strconv.Itoa(f.Height) + "px"
+		lineHeight = size
+	}
+	if v := cssValue(d.FontFamily); v != "" {
+		family = v
+		if size != "" {
+			family = documentFont + "," + v
+		}
+	}
+	if v := cssValue(d.LineHeight); v != "" {
+		lineHeight = v
+	}
+	s := "font-family:" + family
+	if size != "" {
+		s += ";font-size:" + size
+	}
+	s += ";line-height:" + lineHeight
+	if v := cssValue(d.LetterSpacing); v != "" {
+		s += ";letter-spacing:" + v
+	}
+	return s
+}
+
+// documentFit returns the CSS declarations of the <pre> element that scale the font size
+// to the width of the body, which is a query container, where the width of each character
+// is a ratio of the font size, and the font size is never larger than the font height.
+func (d *Decoder) documentFit() string {
+	// the advance width of most monospace fonts is 0.6em
+	const monospace = 0.6
+	advance, size := monospace, "1em"
+	if f := d.grid.font; f != nil {
+		const vga, nine = 8, 9
+		w := f.Width
+		if d.grid.nine && w == vga {
+			w = nine
+		}
+		advance, size = float64(w)/float64(f.Height), strconv.Itoa(f.Height)+"px"
+	}
+	n := strconv.FormatFloat(float64(max(d.grid.width, 1))*advance, 'f', -1, 64)
+	s := "font-size:min(" + size + ",calc(100cqw / " + n + "))"
+	if cssValue(d.LineHeight) == "" {
+		s += ";line-height:1"
+	}
+	return s
+}
+
+// metaTags returns the <meta> elements of the caption using the Dublin Core names.
+func (c caption) metaTags() []metaTag {
+	tags := []metaTag{}
+	add := func(name, content string) {
+		if content != "" {
+			tags = append(tags, metaTag{name, content})
+		}
+	}
+	add("dcterms.title", c.Title)
+	add("author", c.Author)
+	add("dcterms.creator", c.Author)
+	add("dcterms.publisher", c.Group)
+	add("dcterms.created", c.Date)
+	add("description", strings.Join(c.Comments, " "))
+	return tags
+}
+
+// jsonLD returns the caption as the JSON-LD of a schema.org VisualArtwork.
+func (c caption) jsonLD() (template.JS, error) {
+	type thing struct {
+		Type string `json:"@type"`
+		Name string `json:"name"`
+	}
+	v := struct {
+		Context     string `json:"@context"`
+		Type        string `json:"@type"`
+		Name        string `json:"name,omitempty"`
+		Creator     *thing `json:"creator,omitempty"`
+		Publisher   *thing `json:"publisher,omitempty"`
+		DateCreated string `json:"dateCreated,omitempty"`
+		Description string `json:"description,omitempty"`
+	}{
+		Context:     "https://schema.org",
+		Type:        "VisualArtwork",
+		Name:        c.Title,
+		DateCreated: c.Date,
+		Description: strings.Join(c.Comments, "\n"),
+	}
+	if c.Author != "" {
+		v.Creator = &thing{"Person", c.Author}
+	}
+	if c.Group != "" {
+		v.Publisher = &thing{"Organization", c.Group}
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err //nolint:wrapcheck
+	}
+	return template.JS(b), nil //nolint:gosec
+}
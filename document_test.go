@@ -0,0 +1,127 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bengarrett/binbump"
+	"github.com/bengarrett/binbump/sauce"
+)
+
+func ExampleDecoder_WriteDocument() {
+	data := []byte{0x48, 0x1e, 0x69, 0x1e}
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	if err := d.WriteDocument(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: <!DOCTYPE html>
+	// <html lang="en">
+	// <head>
+	// <meta charset="utf-8">
+	// <title>binbump</title>
+	// <style>
+	// body{background:#000;margin:0}
+	// pre{margin:0;font-family:monospace;line-height:1}
+	// </style>
+	// </head>
+	// <body>
+	// <pre><div><span style="color:#ff5;background-color:#00a;">Hi</span>
+	// </div></pre>
+	// </body>
+	// </html>
+}
+
+func ExampleWithFont() {
+	// a raw VGA font of 256 glyphs, 8x16 pixels, where every glyph is a solid block
+	font, err := binbump.ParseFont(bytes.Repeat([]byte{0xff}, 256*16))
+	if err != nil {
+		panic(err)
+	}
+	data := []byte{0x48, 0x1e, 0x69, 0x1e}
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil, binbump.WithFont(font))
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	var b bytes.Buffer
+	if err := d.WriteDocument(&b); err != nil {
+		panic(err)
+	}
+	for line := range strings.Lines(b.String()) {
+		if strings.HasPrefix(line, "@font-face") {
+			line = line[:strings.Index(line, ";base64,")] + "...\n"
+		}
+		if strings.HasPrefix(line, "@") || strings.HasPrefix(line, "pre") {
+			fmt.Print(line)
+		}
+	}
+	// Output: @font-face{font-family:binbump;src:url(data:font/woff2...
+	// pre{margin:0;font-family:binbump,monospace;font-size:16px;line-height:16px}
+}
+
+func ExampleWithMetadata() {
+	data := []byte{0x48, 0x1e, 0x69, 0x1e}
+	r := sauce.Bin(80)
+	r.Title, r.Author, r.Group = "Hi", "Ada", "Acid"
+	r.Date = time.Date(1996, 4, 1, 0, 0, 0, 0, time.UTC)
+	r.Comments = []string{"Greets to all"}
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil,
+		binbump.WithRecord(r), binbump.WithMetadata(binbump.MetaTags|binbump.Caption|binbump.JSONLD))
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	if err := d.WriteDocument(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: <!DOCTYPE html>
+	// <html lang="en">
+	// <head>
+	// <meta charset="utf-8">
+	// <title>Hi</title>
+	// <meta name="dcterms.title" content="Hi">
+	// <meta name="author" content="Ada">
+	// <meta name="dcterms.creator" content="Ada">
+	// <meta name="dcterms.publisher" content="Acid">
+	// <meta name="dcterms.created" content="1996-04-01">
+	// <meta name="description" content="Greets to all">
+	// <script type="application/ld+json">{"@context":"https://schema.org","@type":"VisualArtwork","name":"Hi","creator":{"@type":"Person","name":"Ada"},"publisher":{"@type":"Organization","name":"Acid"},"dateCreated":"1996-04-01","description":"Greets to all"}</script>
+	// <style>
+	// body{background:#000;margin:0}
+	// figure{margin:0}
+	// figcaption{color:#aaa;font-family:sans-serif;padding:.5em}
+	// pre{margin:0;font-family:monospace;line-height:1}
+	// </style>
+	// </head>
+	// <body>
+	// <figure>
+	// <pre><div><span style="color:#ff5;background-color:#00a;">Hi</span>
+	// </div></pre>
+	// <figcaption><cite>Hi</cite> by Ada of Acid, <time>1996-04-01</time><br>Greets to all</figcaption>
+	// </figure>
+	// </body>
+	// </html>
+}
+
+func ExampleWithResponsive() {
+	data := []byte{0x48, 0x1e, 0x69, 0x1e}
+	d := binbump.NewDecoder(160, 0, binbump.StandardCGA, nil, binbump.WithResponsive())
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	var b bytes.Buffer
+	if err := d.WriteDocument(&b); err != nil {
+		panic(err)
+	}
+	style, _, _ := strings.Cut(b.String(), "</style>")
+	_, style, _ = strings.Cut(style, "<style>")
+	fmt.Println(strings.TrimSpace(style))
+	// Output: body{background:#000;margin:0}
+	// pre{margin:0;font-family:monospace;line-height:1}
+	// body{container-type:inline-size}
+	// pre{font-size:min(1em,calc(100cqw / 96));line-height:1}
+}
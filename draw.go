@@ -0,0 +1,80 @@
+package binbump
+
+import "image"
+
+// LineStyle is the style of the box-drawing characters used by the drawing methods of a grid.
+type LineStyle uint8
+
+const (
+	SingleLine LineStyle = iota // SingleLine uses the ─ │ ┌ ┐ └ ┘ characters.
+	DoubleLine                  // DoubleLine uses the ═ ║ ╔ ╗ ╚ ╝ characters.
+)
+
+// lineRunes are the horizontal, vertical, top-left, top-right, bottom-left
+// and bottom-right characters of each style.
+//
+//nolint:gochecknoglobals
+var lineRunes = map[LineStyle][6]rune{
+	SingleLine: {'─', '│', '┌', '┐', '└', '┘'},
+	DoubleLine: {'═', '║', '╔', '╗', '╚', '╝'},
+}
+
+// HLine draws a horizontal line of the length from the zero-based column and row,
+// using the foreground and background color codes.
+// The line is clipped to the grid.
+func (g *Grid) HLine(x, y, length int, style LineStyle, fg, bg uint8) {
+	c := g.lineCell(style, 0, fg, bg)
+	for i := range max(length, 0) {
+		g.Set(x+i, y, c)
+	}
+}
+
+// VLine draws a vertical line of the length from the zero-based column and row,
+// using the foreground and background color codes.
+// The line is clipped to the grid.
+func (g *Grid) VLine(x, y, length int, style LineStyle, fg, bg uint8) {
+	c := g.lineCell(style, 1, fg, bg)
+	for i := range max(length, 0) {
+		g.Set(x, y+i, c)
+	}
+}
+
+// Box draws the outline of the rectangle, where the Min point is inclusive and
+// the Max point is exclusive, using the foreground and background color codes.
+// The inside of the box is not changed, use [Grid.Fill] to clear it.
+// The box is clipped to the grid.
+func (g *Grid) Box(rect image.Rectangle, style LineStyle, fg, bg uint8) {
+	const topLeft, topRight, bottomLeft, bottomRight = 2, 3, 4, 5
+	rect = rect.Canon()
+	if rect.Empty() {
+		return
+	}
+	left, top := rect.Min.X, rect.Min.Y
+	right, bottom := rect.Max.X-1, rect.Max.Y-1
+	g.HLine(left, top, rect.Dx(), style, fg, bg)
+	g.HLine(left, bottom, rect.Dx(), style, fg, bg)
+	g.VLine(left, top, rect.Dy(), style, fg, bg)
+	g.VLine(right, top, rect.Dy(), style, fg, bg)
+	if rect.Dx() < 2 || rect.Dy() < 2 {
+		return
+	}
+	g.Set(left, top, g.lineCell(style, topLeft, fg, bg))
+	g.Set(right, top, g.lineCell(style, topRight, fg, bg))
+	g.Set(left, bottom, g.lineCell(style, bottomLeft, fg, bg))
+	g.Set(right, bottom, g.lineCell(style, bottomRight, fg, bg))
+}
+
+// lineCell returns the cell of a box-drawing character of the style,
+// encoded to the charset of the grid.
+func (g *Grid) lineCell(style LineStyle, i int, fg, bg uint8) Cell {
+	const unknown = '+'
+	runes, ok := lineRunes[style]
+	if !ok {
+		runes = lineRunes[SingleLine]
+	}
+	b, ok := g.charset.EncodeRune(runes[i])
+	if !ok {
+		b = unknown
+	}
+	return Cell{Char: b, Attr: attribute(fg, bg)}
+}
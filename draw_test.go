@@ -0,0 +1,38 @@
+package binbump_test
+
+import (
+	"fmt"
+	"image"
+	"os"
+
+	"github.com/bengarrett/binbump"
+	"golang.org/x/text/encoding/charmap"
+)
+
+func ExampleGrid_Box() {
+	g := binbump.NewGrid(12, 3, binbump.StandardCGA, nil)
+	g.Box(image.Rect(0, 0, 12, 3), binbump.DoubleLine, 15, 1)
+	g.Print(2, 1, "binbump", 14, 0)
+	for _, row := range g.Rows() {
+		for _, c := range row {
+			fmt.Print(string(charmap.CodePage437.DecodeByte(c.Char)))
+		}
+		fmt.Println()
+	}
+	fmt.Println(g.Width(), g.Height())
+	// Output:
+	// ╔══════════╗
+	// ║ binbump  ║
+	// ╚══════════╝
+	// 12 3
+}
+
+func ExampleGrid_HLine() {
+	g := binbump.NewGrid(5, 1, binbump.StandardCGA, nil)
+	g.HLine(1, 0, 3, binbump.SingleLine, 7, 0)
+	if err := g.WriteHTML(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: <div><span style="color:#aaa;background-color:#000;"> ─── </span>
+	// </div>
+}
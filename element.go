@@ -0,0 +1,51 @@
+package binbump
+
+import (
+	"html"
+	"io"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// namePattern matches the valid tag and attribute names of the outer element.
+//
+//nolint:gochecknoglobals
+var namePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_:.-]*$`)
+
+// element returns the tag name of the outer element.
+func (d *Decoder) element() string {
+	if namePattern.MatchString(d.Element) {
+		return strings.ToLower(d.Element)
+	}
+	return "div"
+}
+
+// openElement writes the opening tag of the outer element, with the id, class, other
+// attributes and any style, where the attributes with an invalid name are skipped.
+func (d *Decoder) openElement(w io.StringWriter, style string) {
+	attr := func(name, value string) {
+		_, _ = w.WriteString(" " + name + `="` + html.EscapeString(value) + `"`)
+	}
+	_, _ = w.WriteString("<" + d.element())
+	if d.ElementID != "" {
+		attr("id", d.ElementID)
+	}
+	if class := strings.Join(strings.Fields(strings.Join(d.ElementClass, " ")), " "); class != "" {
+		attr("class", class)
+	}
+	for _, name := range slices.Sorted(maps.Keys(d.ElementAttrs)) {
+		switch strings.ToLower(name) {
+		case "id", "class", "style":
+			continue
+		}
+		if namePattern.MatchString(name) {
+			attr(name, d.ElementAttrs[name])
+		}
+	}
+	if style != "" {
+		_, _ = w.WriteString(` style="` + style + `"`)
+	}
+	_, _ = w.WriteString(">")
+}
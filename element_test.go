@@ -0,0 +1,25 @@
+package binbump_test
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleDecoder_Write_element() {
+	data := []byte{0x48, 0x1e, 0x69, 0x1e}
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	d.Element = "section"
+	d.ElementID = "artwork"
+	d.ElementClass = []string{"ansi", "dark"}
+	d.ElementAttrs = map[string]string{"data-file": "hi.bin", "aria-label": "Hi & bye"}
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: <section id="artwork" class="ansi dark" aria-label="Hi &amp; bye" data-file="hi.bin"><span style="color:#ff5;background-color:#00a;">Hi</span>
+	// </section>
+}
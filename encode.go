@@ -0,0 +1,56 @@
+package binbump
+
+import (
+	"strings"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// EncodeText converts the UTF-8 text to a binary screen dump of character and attribute
+// pairs, so that programs can generate simple screens, such as banners and status screens.
+// The characters are encoded to [charmap.CodePage437], where any that are not in the
+// charset are replaced with a question mark, and every cell uses the foreground and
+// background color codes. Background colors 8 to 15 set the blink bit.
+//
+// Each line of the text is a row that wraps at the width, and the rows are padded with
+// spaces to the width. Tabs advance to the next multiple of 8 columns, and a final
+// newline is ignored. If width <= 0, 80 is used.
+func EncodeText(s string, width int, fg, bg uint8) []byte {
+	const columns, tab, unknown = 80, 8, '?'
+	if width <= 0 {
+		width = columns
+	}
+	attr := attribute(fg, bg)
+	s = strings.TrimSuffix(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+	if s == "" {
+		return []byte{}
+	}
+	var b []byte
+	for line := range strings.SplitSeq(s, "\n") {
+		col := 0
+		put := func(c byte) {
+			if col == width {
+				col = 0
+			}
+			b = append(b, c, attr)
+			col++
+		}
+		for _, r := range line {
+			if r == '\t' {
+				for put(' '); col%tab != 0 && col < width; {
+					put(' ')
+				}
+				continue
+			}
+			c, ok := charmap.CodePage437.EncodeRune(r)
+			if !ok {
+				c = unknown
+			}
+			put(c)
+		}
+		for col == 0 || col%width != 0 {
+			put(' ')
+		}
+	}
+	return b
+}
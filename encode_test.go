@@ -0,0 +1,20 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleEncodeText() {
+	data := binbump.EncodeText("Hello,\nWorld!", 8, 14, 1)
+	fmt.Printf("% x\n", data[:4])
+	d := binbump.NewDecoder(8, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	fmt.Printf("%q", d.Grid().Transcript())
+	// Output: 48 1e 65 1e
+	// "Hello,\nWorld!\n"
+}
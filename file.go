@@ -0,0 +1,112 @@
+package binbump
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/bengarrett/binbump/sauce"
+)
+
+// DecodeFile opens and decodes the binary screen dump file at the named path.
+// Any SAUCE metadata is removed with [TrimMetadata], and the width is read from
+// the SAUCE record of a binary text file, or otherwise guessed with [GuessWidth].
+// The charset is chosen with [DetectCharset].
+//
+// When the SAUCE font name matches a font of [RegisterFont], it is used by the renderers
+// unless a font is set by [WithFont], and the SAUCE letter spacing flag sets 9 pixel wide
+// characters with [Grid.SetLetterSpacing9].
+func DecodeFile(name string, opts ...Option) (*Decoder, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("decode file: %w", err)
+	}
+	d, err := decodeData(data, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("decode file %s: %w", name, err)
+	}
+	return d, nil
+}
+
+// decodeData decodes the data of a binary screen dump file for [DecodeFile].
+func decodeData(data []byte, opts ...Option) (*Decoder, error) {
+	cs := DetectCharset(data)
+	d := NewDecoder(dataWidth(data), 0, StandardCGA, cs, opts...)
+	if r, err := sauce.Decode(data); err == nil {
+		if d.record == nil {
+			d.record = &r
+		}
+		if f, err := FontByName(r.Font); err == nil && d.grid.font == nil {
+			d.grid.SetFont(f)
+		}
+		if r.Flags&sauce.LetterSpacing9 != 0 {
+			d.grid.SetLetterSpacing9(true)
+		}
+	}
+	if err := d.Read(bytes.NewReader(TrimMetadata(data))); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// dataWidth returns the width of the data read from the SAUCE record of a binary text file,
+// otherwise the width guessed with [GuessWidth], or 0 if the width cannot be guessed.
+func dataWidth(data []byte) int {
+	if r, err := sauce.Decode(data); err == nil && r.DataType == sauce.BinaryText && r.FileType > 0 {
+		// the file type of binary text is half the width
+		return int(r.FileType) * 2 //nolint:mnd
+	}
+	if guesses := GuessWidth(data); len(guesses) > 0 && guesses[0].Confidence > 0 {
+		return guesses[0].Width
+	}
+	return 0
+}
+
+// SaveHTML decodes the binary screen dump file at the input path using [DecodeFile],
+// and saves the HTML fragment to the output path with [SaveFile], so that a failed
+// conversion never leaves a partially written file.
+func SaveHTML(inPath, outPath string, opts ...Option) error {
+	d, err := DecodeFile(inPath, opts...)
+	if err != nil {
+		return err
+	}
+	if err := SaveFile(outPath, d.Write); err != nil {
+		return fmt.Errorf("save html: %w", err)
+	}
+	return nil
+}
+
+// SaveFile saves the output of write to the named file. The output is buffered and
+// written to a temporary file in the same directory, that replaces the named file
+// once complete, so that a failed write never leaves a partially written file.
+func SaveFile(name string, write func(io.Writer) error) error {
+	f, err := os.CreateTemp(filepath.Dir(name), ".binbump-*")
+	if err != nil {
+		return fmt.Errorf("save: %w", err)
+	}
+	tmp := f.Name()
+	defer os.Remove(tmp) // no-op after the rename
+	w := bufio.NewWriter(f)
+	if err := write(w); err != nil {
+		f.Close()
+		return fmt.Errorf("save %s: %w", name, err)
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return fmt.Errorf("save flush: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("save close: %w", err)
+	}
+	const perm = 0o644
+	if err := os.Chmod(tmp, perm); err != nil {
+		return fmt.Errorf("save: %w", err)
+	}
+	if err := os.Rename(tmp, name); err != nil {
+		return fmt.Errorf("save: %w", err)
+	}
+	return nil
+}
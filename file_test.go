@@ -0,0 +1,65 @@
+package binbump_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleDecodeFile() {
+	d, err := binbump.DecodeFile("testdata/test1.bin")
+	if err != nil {
+		panic(err)
+	}
+	g := d.Grid()
+	fmt.Println(g.Width(), g.Height())
+	fmt.Print(g.Transcript())
+	// Output: 80 25
+	// THIS IS A Φ TEST Φ
+}
+
+func ExampleSaveHTML() {
+	dir, err := os.MkdirTemp("", "binbump")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "test1.html")
+	if err := binbump.SaveHTML("testdata/test1.bin", name); err != nil {
+		panic(err)
+	}
+	st, err := os.Stat(name)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(st.Name(), st.Size() > 0, st.Mode().Perm())
+	// Output: test1.html true -rw-r--r--
+}
+
+func ExampleSaveFile() {
+	dir, err := os.MkdirTemp("", "binbump")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+	name := filepath.Join(dir, "test1.txt")
+	if err := os.WriteFile(name, []byte("unchanged"), 0o644); err != nil {
+		panic(err)
+	}
+	err = binbump.SaveFile(name, func(w io.Writer) error {
+		io.WriteString(w, "partial")
+		return errors.New("render failed")
+	})
+	fmt.Println(err != nil)
+	b, _ := os.ReadFile(name)
+	fmt.Println(string(b))
+	entries, _ := os.ReadDir(dir)
+	fmt.Println(len(entries), "file")
+	// Output: true
+	// unchanged
+	// 1 file
+}
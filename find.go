@@ -0,0 +1,38 @@
+package binbump
+
+import "slices"
+
+// Match is the location of a text match within a row of a grid.
+type Match struct {
+	Row int // Row is the zero-based row of the match.
+	Col int // Col is the zero-based column of the first cell of the match.
+	End int // End is the zero-based column after the last cell of the match.
+}
+
+// Find returns the locations of the non-overlapping matches of substr in the text of the grid,
+// so that indexers can locate group names, board numbers or artist tags inside dumps.
+// The cells are decoded using the charset of the grid, and matches do not continue across rows.
+// An empty substr has no matches.
+func (g *Grid) Find(substr string) []Match {
+	needle := []rune(substr)
+	if len(needle) == 0 {
+		return nil
+	}
+	matches := []Match{}
+	runes := []rune{}
+	for y, row := range g.rows {
+		runes = runes[:0]
+		for _, c := range row {
+			runes = append(runes, g.rune(c))
+		}
+		for x := 0; x+len(needle) <= len(runes); {
+			if !slices.Equal(runes[x:x+len(needle)], needle) {
+				x++
+				continue
+			}
+			matches = append(matches, Match{Row: y, Col: x, End: x + len(needle)})
+			x += len(needle)
+		}
+	}
+	return matches
+}
@@ -0,0 +1,20 @@
+package binbump_test
+
+import (
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_Find() {
+	g := binbump.NewGrid(40, 3, binbump.StandardCGA, nil)
+	g.Print(2, 0, "ACiD Productions", 15, 1)
+	g.Print(10, 2, "ACiD ░ 1996", 7, 0)
+	for _, m := range g.Find("ACiD") {
+		fmt.Printf("row %d, columns %d-%d\n", m.Row, m.Col, m.End)
+	}
+	fmt.Println(len(g.Find("░ 19")))
+	// Output: row 0, columns 2-6
+	// row 2, columns 10-14
+	// 1
+}
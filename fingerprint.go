@@ -0,0 +1,68 @@
+package binbump
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+)
+
+// Fingerprint returns a hash of the decoded screen of the binary dump found in the Reader,
+// so that archives can deduplicate visually identical dumps with differing metadata.
+// It assumes the Reader is using IBM Code Page 437 encoding and any SAUCE metadata
+// is removed with [TrimMetadata].
+func Fingerprint(r io.Reader) (string, error) {
+	if r == nil {
+		return "", ErrReader
+	}
+	d := NewDecoder(0, 0, StandardCGA, nil, WithTrimMetadata())
+	if err := d.Read(r); err != nil {
+		return "", err
+	}
+	return d.Grid().Fingerprint(), nil
+}
+
+// Fingerprint returns the hexadecimal SHA-256 hash of the normalized cells of the grid.
+// The cells are normalized so that visually identical grids share the same fingerprint,
+// where the blank cells on a black background at the end of each row,
+// and the rows at the end of the grid without any other cells are ignored,
+// and the foreground color of the other blank cells is ignored.
+func (g *Grid) Fingerprint() string {
+	const bgBits = 0x70
+	rows := make([][]Cell, 0, len(g.rows))
+	for _, row := range g.rows {
+		end := len(row)
+		for end > 0 && g.emptyCell(row[end-1]) {
+			end--
+		}
+		rows = append(rows, row[:end])
+	}
+	for len(rows) > 0 && len(rows[len(rows)-1]) == 0 {
+		rows = rows[:len(rows)-1]
+	}
+	h := sha256.New()
+	for _, row := range rows {
+		for _, c := range row {
+			attr := c.Attr
+			if g.Blank(c) && !g.underline(c) {
+				attr &= bgBits
+			}
+			h.Write([]byte{g.fingerprintChar(c), attr})
+		}
+		h.Write([]byte{'\n', 0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// emptyCell reports whether the cell is a blank character on a black background.
+func (g *Grid) emptyCell(c Cell) bool {
+	_, bg := g.attrColors(c)
+	return bg == 0 && g.Blank(c) && !g.underline(c)
+}
+
+// fingerprintChar returns the character of the cell, where all the blank characters are a space.
+func (g *Grid) fingerprintChar(c Cell) byte {
+	if g.Blank(c) {
+		return ' '
+	}
+	return c.Char
+}
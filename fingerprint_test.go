@@ -0,0 +1,26 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+	"github.com/bengarrett/binbump/sauce"
+)
+
+func ExampleFingerprint() {
+	a := []byte{0x48, 0x1e, 0x69, 0x1e, 0x20, 0x07, 0x00, 0x00}
+	b := []byte{0x48, 0x1e, 0x69, 0x1e}
+	var c bytes.Buffer
+	c.Write(b)
+	if err := sauce.Append(&c, sauce.Bin(2)); err != nil {
+		panic(err)
+	}
+	fa, _ := binbump.Fingerprint(bytes.NewReader(a))
+	fb, _ := binbump.Fingerprint(bytes.NewReader(b))
+	fc, _ := binbump.Fingerprint(&c)
+	fmt.Println(fa == fb, fb == fc)
+	fmt.Println(fa)
+	// Output: true true
+	// a052df636e63b650d982dcf9e1d169a72dbaed2f8fa604699838a51e6c20bd18
+}
@@ -0,0 +1,53 @@
+package binbump
+
+import "strings"
+
+// elementStyle returns the CSS declarations of the outer element, which are the
+// [Decoder.backgroundStyle] and the [Decoder.fontStyle].
+func (d *Decoder) elementStyle() string {
+	return d.backgroundStyle() + d.fontStyle()
+}
+
+// backgroundStyle returns the CSS declaration of the Background color,
+// or an empty string when it is not set.
+func (d *Decoder) backgroundStyle() string {
+	if !d.Background.Valid() {
+		return ""
+	}
+	return d.Background.BG()
+}
+
+// background reports whether the color matches the Background color of the page.
+func (d *Decoder) background(c Color) bool {
+	return d.Background.Valid() && c.hex() == d.Background.hex()
+}
+
+// fontStyle returns the CSS declarations of the LetterSpacing, LineHeight and FontFamily
+// fields, or an empty string when they are not set.
+func (d *Decoder) fontStyle() string {
+	s := ""
+	for _, p := range [...]struct{ name, value string }{
+		{"letter-spacing", d.LetterSpacing},
+		{"line-height", d.LineHeight},
+		{"font-family", d.FontFamily},
+	} {
+		if v := cssValue(p.value); v != "" {
+			s += p.name + ":" + v + ";"
+		}
+	}
+	return s
+}
+
+// cssValue returns the CSS property value without the characters that could end the
+// declaration, the style attribute or the style element, such as ; { } " < and &.
+func cssValue(s string) string {
+	return strings.TrimSpace(strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case strings.ContainsRune(" '.,-+%#()/_", r):
+			return r
+		}
+		return -1
+	}, s))
+}
@@ -0,0 +1,24 @@
+package binbump_test
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleDecoder_Write_fontFamily() {
+	data := []byte{0x48, 0x1e, 0x69, 0x1e}
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	d.LetterSpacing = "1px"
+	d.LineHeight = "1.25"
+	d.FontFamily = "'IBM VGA', monospace"
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: <div style="letter-spacing:1px;line-height:1.25;font-family:'IBM VGA', monospace;"><span style="color:#ff5;background-color:#00a;">Hi</span>
+	// </div>
+}
@@ -0,0 +1,56 @@
+package binbump
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// FuncMap returns the template functions for [html/template] users, so that site generators
+// can render binary screen dumps within their templates, instead of pre-rendering and
+// passing strings around. The binbump function takes the data as a []byte, string or
+// io.Reader, the width, and optional palette or charset names, and returns the HTML fragment.
+//
+//	{{ binbump .Data 80 }}
+//	{{ binbump .Data 160 "revised-cga" "cp866" }}
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"binbump": templateFunc,
+	}
+}
+
+// templateFunc is the binbump template function.
+func templateFunc(data any, width int, names ...string) (template.HTML, error) {
+	var r io.Reader
+	switch v := data.(type) {
+	case []byte:
+		r = bytes.NewReader(v)
+	case string:
+		r = bytes.NewReader([]byte(v))
+	case io.Reader:
+		r = v
+	default:
+		return "", fmt.Errorf("binbump template %T: %w", data, ErrTemplateData)
+	}
+	pal := StandardCGA
+	var cs *charmap.Charmap
+	for _, name := range names {
+		if p, err := PaletteByName(name); err == nil {
+			pal = p
+			continue
+		}
+		c, err := CharsetByName(name)
+		if err != nil {
+			return "", fmt.Errorf("binbump template %q: %w, %w", name, ErrPalette, ErrCharset)
+		}
+		cs = c
+	}
+	d := NewDecoder(width, 0, pal, cs)
+	if err := d.Read(r); err != nil {
+		return "", err
+	}
+	return d.HTML(), nil
+}
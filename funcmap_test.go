@@ -0,0 +1,19 @@
+package binbump_test
+
+import (
+	"html/template"
+	"os"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleFuncMap() {
+	const page = `<pre>{{ binbump .Data 2 "revised-cga" }}</pre>`
+	t := template.Must(template.New("page").Funcs(binbump.FuncMap()).Parse(page))
+	data := struct{ Data []byte }{Data: []byte{0x48, 0x1e, 0x69, 0x1e}}
+	if err := t.Execute(os.Stdout, data); err != nil {
+		panic(err)
+	}
+	// Output: <pre><div><span style="color:#f3f34e;background-color:#0000c4;">Hi</span>
+	// </div></pre>
+}
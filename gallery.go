@@ -0,0 +1,136 @@
+package binbump
+
+import (
+	"bufio"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/bengarrett/binbump/sauce"
+)
+
+// galleryItem is a binary screen dump file of a gallery.
+type galleryItem struct {
+	Name   string        // Name of the file.
+	Href   string        // Href is the relative link to the full render.
+	Record sauce.Record  // Record is the SAUCE metadata.
+	Date   string        // Date is the SAUCE date formatted as YYYY-MM-DD.
+	Thumb  template.HTML // Thumb is the minified fragment of the screen.
+	Screen template.HTML // Screen is the full fragment of the screen.
+}
+
+// galleryIndex is the template of the gallery index page.
+//
+//nolint:gochecknoglobals
+var galleryIndex = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body{font-family:sans-serif}
+table{border-collapse:collapse}
+td,th{padding:.5em;text-align:left;vertical-align:top;border-bottom:1px solid #ccc}
+.thumb{font-family:monospace;font-size:2px;line-height:2px;white-space:pre}
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<table>
+<tr><th>Screen</th><th>File</th><th>Title</th><th>Author</th><th>Group</th><th>Date</th></tr>
+{{range .Items}}<tr><td><a class="thumb" href="{{.Href}}">{{.Thumb}}</a></td><td><a href="{{.Href}}">{{.Name}}</a></td><td>{{.Record.Title}}</td><td>{{.Record.Author}}</td><td>{{.Record.Group}}</td><td>{{.Date}}</td></tr>
+{{end}}</table>
+</body>
+</html>
+`))
+
+// galleryPage is the template of the full render page of a file.
+//
+//nolint:gochecknoglobals
+var galleryPage = template.Must(template.New("page").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Name}}</title>
+<style>
+body{background:#000}
+pre{font-family:monospace;line-height:1}
+</style>
+</head>
+<body>
+<pre>{{.Screen}}</pre>
+</body>
+</html>
+`))
+
+// WriteGallery creates a static gallery of the binary screen dump files in the directory,
+// with an index.html page listing a thumbnail of each screen with the title, author,
+// group and date of its SAUCE metadata, and linking to a full render page of each file.
+// The files are decoded using [DecodeFile].
+func WriteGallery(dir string, files []string, opts ...Option) error {
+	const perm = 0o755
+	if err := os.MkdirAll(dir, perm); err != nil {
+		return fmt.Errorf("write gallery: %w", err)
+	}
+	items := make([]galleryItem, 0, len(files))
+	used := map[string]bool{"index.html": true}
+	for _, name := range files {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return fmt.Errorf("write gallery: %w", err)
+		}
+		d, err := decodeData(data, opts...)
+		if err != nil {
+			return fmt.Errorf("write gallery %s: %w", name, err)
+		}
+		item := galleryItem{Name: filepath.Base(name)}
+		if r, err := sauce.Decode(data); err == nil {
+			item.Record = r
+			if !r.Date.IsZero() {
+				item.Date = r.Date.Format("2006-01-02")
+			}
+		}
+		item.Screen = d.HTML()
+		d.Minify = true
+		item.Thumb = d.HTML()
+		base := strings.TrimSuffix(item.Name, filepath.Ext(item.Name))
+		item.Href = base + ".html"
+		for i := 2; used[item.Href]; i++ {
+			item.Href = base + "-" + strconv.Itoa(i) + ".html"
+		}
+		used[item.Href] = true
+		if err := writeTemplate(filepath.Join(dir, item.Href), galleryPage, item); err != nil {
+			return err
+		}
+		items = append(items, item)
+	}
+	index := struct {
+		Title string
+		Items []galleryItem
+	}{Title: filepath.Base(dir), Items: items}
+	return writeTemplate(filepath.Join(dir, "index.html"), galleryIndex, index)
+}
+
+// writeTemplate creates the named file with the executed template.
+func writeTemplate(name string, t *template.Template, data any) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return fmt.Errorf("write gallery: %w", err)
+	}
+	w := bufio.NewWriter(f)
+	if err := t.Execute(w, data); err != nil {
+		f.Close()
+		return fmt.Errorf("write gallery %s: %w", name, err)
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return fmt.Errorf("write gallery flush: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("write gallery close: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package binbump_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleWriteGallery() {
+	dir, err := os.MkdirTemp("", "gallery")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := binbump.WriteGallery(dir, []string{"testdata/test1.bin"}); err != nil {
+		panic(err)
+	}
+	names, err := filepath.Glob(filepath.Join(dir, "*.html"))
+	if err != nil {
+		panic(err)
+	}
+	for _, name := range names {
+		fmt.Println(filepath.Base(name))
+	}
+	// Output: index.html
+	// test1.html
+}
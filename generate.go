@@ -0,0 +1,66 @@
+package binbump
+
+// Pattern is a synthetic screen created by [Generate].
+type Pattern uint
+
+const (
+	// ColorChart shows every foreground color in the columns against every background
+	// color in the rows, using the hexadecimal digit of the foreground color as the character.
+	// The backgrounds 8 to 15 set the blink bit.
+	ColorChart Pattern = iota
+	// CharTable shows every character code in order, using the gray on black attribute,
+	// which with a width of 16 is a table of the 256 characters in 16 rows.
+	CharTable
+	// Gradient shades each row from the background to the foreground color, using the space,
+	// light, medium and dark shade and full block characters, with a foreground color
+	// of 1 to 15 for each row.
+	Gradient
+)
+
+// Spec is the specification of the synthetic screen created by [Generate].
+type Spec struct {
+	Pattern Pattern
+	Width   int // Width is the number of columns, if <= 0, 80 is used.
+	Height  int // Height is the number of rows, if <= 0, 25 is used.
+}
+
+// Generate returns the deterministic binary screen dump data of the spec,
+// so tests and demos can be written without shipping binary files.
+// An unknown pattern returns a screen of blank cells.
+func Generate(spec Spec) []byte {
+	const columns, rows, pair = 80, 25, 2
+	width, height := spec.Width, spec.Height
+	if width <= 0 {
+		width = columns
+	}
+	if height <= 0 {
+		height = rows
+	}
+	data := make([]byte, 0, width*height*pair)
+	for y := range height {
+		for x := range width {
+			c := spec.Pattern.cell(x, y, width)
+			data = append(data, c.Char, c.Attr)
+		}
+	}
+	return data
+}
+
+// cell returns the cell of the pattern at the zero-based column and row.
+//
+//nolint:gosec,mnd
+func (p Pattern) cell(x, y, width int) Cell {
+	const digits = "0123456789ABCDEF"
+	switch p {
+	case ColorChart:
+		fg, bg := x%16, y%16
+		return Cell{Char: digits[fg], Attr: attribute(uint8(fg), uint8(bg))}
+	case CharTable:
+		return Cell{Char: byte(y*width + x), Attr: blankCell.Attr}
+	case Gradient:
+		shades := [...]byte{0x20, 0xb0, 0xb1, 0xb2, 0xdb}
+		fg := y%15 + 1
+		return Cell{Char: shades[x*len(shades)/width], Attr: attribute(uint8(fg), 0)}
+	}
+	return blankCell
+}
@@ -0,0 +1,32 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGenerate() {
+	data := binbump.Generate(binbump.Spec{Pattern: binbump.CharTable, Width: 16, Height: 16})
+	d := binbump.NewDecoder(16, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	g := d.Grid()
+	c, _ := g.At(1, 4)
+	fmt.Printf("%d bytes, %q\n", len(data), c.Char)
+	// Output: 512 bytes, 'A'
+}
+
+func ExampleGenerate_colorChart() {
+	data := binbump.Generate(binbump.Spec{Pattern: binbump.ColorChart, Width: 16, Height: 16})
+	d := binbump.NewDecoder(16, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	c, _ := d.Grid().At(14, 1)
+	fg, bg := c.Colors()
+	fmt.Printf("%c %d %d\n", c.Char, fg, bg)
+	// Output: E 14 1
+}
@@ -0,0 +1,73 @@
+package binbump
+
+// Line weights of a box-drawing character arm.
+const (
+	noLine     uint8 = iota // no line
+	singleLine              // single line, ─ │
+	doubleLine              // double line, ═ ║
+)
+
+// arms are the line weights drawn from the center of a box-drawing character
+// to the up, down, left and right edges of the cell.
+type arms struct {
+	up, down, left, right uint8
+}
+
+// boxDrawing are the arms of the box-drawing characters found in IBM Code Page 437.
+//
+//nolint:gochecknoglobals
+var boxDrawing = map[rune]arms{
+	'─': {0, 0, 1, 1}, '│': {1, 1, 0, 0},
+	'┌': {0, 1, 0, 1}, '┐': {0, 1, 1, 0}, '└': {1, 0, 0, 1}, '┘': {1, 0, 1, 0},
+	'├': {1, 1, 0, 1}, '┤': {1, 1, 1, 0}, '┬': {0, 1, 1, 1}, '┴': {1, 0, 1, 1},
+	'┼': {1, 1, 1, 1},
+	'═': {0, 0, 2, 2}, '║': {2, 2, 0, 0},
+	'╒': {0, 1, 0, 2}, '╓': {0, 2, 0, 1}, '╔': {0, 2, 0, 2},
+	'╕': {0, 1, 2, 0}, '╖': {0, 2, 1, 0}, '╗': {0, 2, 2, 0},
+	'╘': {1, 0, 0, 2}, '╙': {2, 0, 0, 1}, '╚': {2, 0, 0, 2},
+	'╛': {1, 0, 2, 0}, '╜': {2, 0, 1, 0}, '╝': {2, 0, 2, 0},
+	'╞': {1, 1, 0, 2}, '╟': {2, 2, 0, 1}, '╠': {2, 2, 0, 2},
+	'╡': {1, 1, 2, 0}, '╢': {2, 2, 1, 0}, '╣': {2, 2, 2, 0},
+	'╤': {0, 1, 2, 2}, '╥': {0, 2, 1, 1}, '╦': {0, 2, 2, 2},
+	'╧': {1, 0, 2, 2}, '╨': {2, 0, 1, 1}, '╩': {2, 0, 2, 2},
+	'╪': {1, 1, 2, 2}, '╫': {2, 2, 1, 1}, '╬': {2, 2, 2, 2},
+}
+
+// block is a rectangle of a block element character, using fractions of the cell size
+// with the origin at the top-left. The shade is the percentage of foreground color.
+type block struct {
+	x, y, w, h float64
+	shade      int
+}
+
+// blockElements are the block and shade characters found in IBM Code Page 437.
+//
+//nolint:gochecknoglobals
+var blockElements = map[rune]block{
+	'█': {0, 0, 1, 1, 100},
+	'▀': {0, 0, 1, 0.5, 100},
+	'▄': {0, 0.5, 1, 0.5, 100},
+	'▌': {0, 0, 0.5, 1, 100},
+	'▐': {0.5, 0, 0.5, 1, 100},
+	'░': {0, 0, 1, 1, 25},
+	'▒': {0, 0, 1, 1, 50},
+	'▓': {0, 0, 1, 1, 75},
+	'■': {0.25, 0.375, 0.5, 0.375, 100},
+}
+
+// mix returns the color channel value blended from the foreground and background values,
+// where shade is the percentage of the foreground.
+func mix(fg, bg uint8, shade int) uint8 {
+	const percent = 100
+	return uint8((int(fg)*shade + int(bg)*(percent-shade)) / percent) //nolint:gosec
+}
+
+// textRune returns r, or a space when r is a control character that cannot be
+// used in plain text output.
+func textRune(r rune) rune {
+	const del = 0x7f
+	if r < ' ' || r == del {
+		return ' '
+	}
+	return r
+}
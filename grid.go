@@ -0,0 +1,298 @@
+package binbump
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	"io"
+	"unicode"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// Cell is a single character and color attribute pair of a binary screen dump.
+type Cell struct {
+	Char byte // Char is the character code in the charset of the screen.
+	Attr byte // Attr is the attribute, bits 0-3 are the foreground and 4-6 the background color.
+}
+
+// Blink reports whether bit 7 of the attribute, the blink bit, is set.
+func (c Cell) Blink() bool {
+	const blink = 0x80
+	return c.Attr&blink != 0
+}
+
+// Colors returns the foreground and background color codes of the cell,
+// which are ints between 0 and 15.
+func (c Cell) Colors() (uint8, uint8) {
+	return decodeAttr(c.Attr)
+}
+
+// Grid is the decoded screen of a binary dump, stored as rows of cells.
+// The final row of a partial or corrupt dump can be shorter than the width.
+type Grid struct {
+	charset *charmap.Charmap
+	colors  Colors
+	mda     bool
+	width   int
+	rows    [][]Cell
+	font    *Font // font is the bitmap font of the renderers
+	nine    bool  // nine uses 9 pixel wide characters with the font
+	blank   func(Cell) bool
+}
+
+// NewGrid creates a Grid of blank cells with a given width (columns) and height (rows),
+// for authoring text mode screens. If width <= 0, 80 is used and if height <= 0, 25 is used.
+//
+// The palette and charset arguments are the same as those of [NewDecoder].
+func NewGrid(width, height int, pal Palette, charset *charmap.Charmap) *Grid {
+	const columns, rows = 80, 25
+	if width <= 0 {
+		width = columns
+	}
+	if height <= 0 {
+		height = rows
+	}
+	if charset == nil {
+		charset = charmap.CodePage437
+	}
+	g := &Grid{
+		charset: charset,
+		width:   width,
+		rows:    make([][]Cell, height),
+	}
+	g.setPalette(pal)
+	for y := range g.rows {
+		g.rows[y] = make([]Cell, width)
+		for x := range g.rows[y] {
+			g.rows[y][x] = blankCell
+		}
+	}
+	return g
+}
+
+// setPalette sets the colorset and the attribute semantics of the palette.
+func (g *Grid) setPalette(pal Palette) {
+	g.mda = false
+	switch pal {
+	case StandardCGA:
+		g.colors = CGA()
+	case RevisedCGA:
+		g.colors = CGARevised()
+	case Tandy:
+		g.colors = TandyPCjr()
+	case Composite:
+		g.colors = CGAComposite()
+	case MDA:
+		g.colors = CGA()
+		g.mda = true
+	default:
+		g.colors = CGA()
+		if c, ok := customColors(pal); ok {
+			g.colors = c
+		}
+	}
+}
+
+// WriteHTML writes to w the HTML fragment of the grid, which is the same
+// as the default output of [Decoder.Write].
+func (g *Grid) WriteHTML(w io.Writer) error {
+	d := &Decoder{grid: g}
+	return d.Write(w)
+}
+
+// Colors returns the colorset used to render the grid.
+func (g *Grid) Colors() Colors {
+	return g.colors
+}
+
+// SetColors replaces the colorset used to render the grid.
+func (g *Grid) SetColors(c Colors) {
+	g.colors = c
+}
+
+// rune returns the Unicode character of the cell using the grid charset.
+func (g *Grid) rune(c Cell) rune {
+	return g.charset.DecodeByte(c.Char)
+}
+
+// attrColors returns the foreground and background color codes used to render the cell.
+// For the monochrome display adapter, the attribute is mapped to black, gray and white
+// color codes.
+func (g *Grid) attrColors(c Cell) (uint8, uint8) {
+	if !g.mda {
+		return c.Colors()
+	}
+	const (
+		black, normal, bright = 0, 7, 15
+		fgBits, bgBits        = 0x07, 0x70
+		intensity             = 0x08
+		reverse               = 0x70
+	)
+	switch c.Attr & (fgBits | bgBits) {
+	case 0:
+		// invisible
+		return black, black
+	case reverse:
+		return black, normal
+	}
+	if c.Attr&intensity != 0 {
+		return bright, black
+	}
+	return normal, black
+}
+
+// underline reports whether the cell is underlined, which is only used by the
+// monochrome display adapter for a blue foreground on a black background.
+func (g *Grid) underline(c Cell) bool {
+	const fgBits, bgBits, blue = 0x07, 0x70, 0x01
+	return g.mda && c.Attr&(fgBits|bgBits) == blue
+}
+
+// Blank reports whether the cell is blank, where only the background color is visible.
+// By default, the blank cells are the NUL, space and other white-space characters,
+// such as the non-breaking space, but the function of [Grid.SetBlank] is used when set.
+// In the Optimize mode, the blank cells are rendered with only their background color,
+// which is also how they are counted for the most common attribute of the Minify mode.
+// The foreground colors of the blank cells are ignored by the [Grid.Fingerprint],
+// and Blank can be used as the transparent function of [Grid.Overlay].
+func (g *Grid) Blank(c Cell) bool {
+	if g.blank != nil {
+		return g.blank(c)
+	}
+	return space(g.rune(c))
+}
+
+// SetBlank sets the function that reports whether a cell is [Grid.Blank], as the conventions
+// vary between art groups, such as a full block with the same foreground and background colors.
+// A nil function restores the default blank cells.
+func (g *Grid) SetBlank(blank func(Cell) bool) {
+	g.blank = blank
+}
+
+// space reports whether the character is a NUL or white-space character.
+func space(r rune) bool {
+	return r == 0 || unicode.IsSpace(r)
+}
+
+// At returns the cell at the zero-based column and row,
+// or false if the position is outside of the decoded cells.
+func (g *Grid) At(col, row int) (Cell, bool) {
+	if row < 0 || row >= len(g.rows) || col < 0 || col >= len(g.rows[row]) {
+		return Cell{}, false
+	}
+	return g.rows[row][col], true
+}
+
+// blankCell is a space using the default gray on black attribute.
+//
+//nolint:gochecknoglobals
+var blankCell = Cell{Char: ' ', Attr: 0x07}
+
+// Set replaces the cell at the zero-based column and row, so screens can be patched
+// or redacted before rendering. It returns false if the position is outside the
+// width or height of the grid. A short final row is extended with blank spaces.
+func (g *Grid) Set(col, row int, c Cell) bool {
+	if row < 0 || row >= len(g.rows) || col < 0 || col >= g.width {
+		return false
+	}
+	for len(g.rows[row]) <= col {
+		g.rows[row] = append(g.rows[row], blankCell)
+	}
+	g.rows[row][col] = c
+	return true
+}
+
+// Fill replaces all the cells within the rectangle, where the Min point is inclusive
+// and the Max point is exclusive. The rectangle is clipped to the grid.
+func (g *Grid) Fill(rect image.Rectangle, c Cell) {
+	rect = rect.Canon().Intersect(image.Rect(0, 0, g.width, len(g.rows)))
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			g.Set(x, y, c)
+		}
+	}
+}
+
+// Reverse swaps the foreground and background colors of all the cells within the rectangle,
+// where the Min point is inclusive and the Max point is exclusive, replicating the inverse
+// video toggle of the display hardware. The intensity and blink bits are unchanged.
+// The rectangle is clipped to the decoded cells, and a rectangle covering the grid
+// reverses the whole screen.
+func (g *Grid) Reverse(rect image.Rectangle) {
+	rect = rect.Canon().Intersect(image.Rect(0, 0, g.width, len(g.rows)))
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		row := g.rows[y]
+		for x := rect.Min.X; x < min(rect.Max.X, len(row)); x++ {
+			row[x].Attr = reverse(row[x].Attr)
+		}
+	}
+}
+
+// reverse returns the attribute with the foreground color bits 0-2 swapped with
+// the background color bits 4-6.
+func reverse(attr byte) byte {
+	const fgBits, bgBits, shift = 0x07, 0x70, 4
+	return attr&^(fgBits|bgBits) | attr&fgBits<<shift | attr&bgBits>>shift
+}
+
+// WriteBIN writes to w the grid as a binary screen dump of character and attribute pairs,
+// such as after the grid was modified. A short final row is padded with blank spaces,
+// so the data is always a multiple of the width.
+func (g *Grid) WriteBIN(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	out := bufio.NewWriter(w)
+	for _, row := range g.rows {
+		for x := range g.width {
+			c := blankCell
+			if x < len(row) {
+				c = row[x]
+			}
+			out.WriteByte(c.Char)
+			out.WriteByte(c.Attr)
+		}
+	}
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("write bin flush: %w", err)
+	}
+	return nil
+}
+
+// attribute returns the attribute byte of the foreground and background color codes.
+// Background colors 8 to 15 set bit 7, which is either blink or a high intensity background.
+func attribute(fg, bg uint8) byte {
+	const nibble, shift = 0x0f, 4
+	return fg&nibble | (bg&nibble)<<shift
+}
+
+// Print writes the UTF-8 string into the grid starting at the zero-based column and row,
+// using the foreground and background color codes, for watermarks, captions or
+// dynamic content. The characters are encoded to the charset of the grid,
+// where any that are not in the charset are replaced with a question mark.
+//
+// A newline continues printing on the next row from the starting column,
+// and text beyond the width or height of the grid is discarded.
+// The return int is the number of cells written.
+func (g *Grid) Print(x, y int, s string, fg, bg uint8) int {
+	const unknown = '?'
+	attr := attribute(fg, bg)
+	col, n := x, 0
+	for _, r := range s {
+		if r == '\n' {
+			col = x
+			y++
+			continue
+		}
+		b, ok := g.charset.EncodeRune(r)
+		if !ok {
+			b = unknown
+		}
+		if g.Set(col, y, Cell{Char: b, Attr: attr}) {
+			n++
+		}
+		col++
+	}
+	return n
+}
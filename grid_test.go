@@ -0,0 +1,109 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"os"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_At() {
+	data := []byte{0x41, 0x07, 0x42, 0x07, 0x43, 0x1f}
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	c, ok := d.Grid().At(0, 1)
+	fg, bg := c.Colors()
+	fmt.Printf("%c %d %d %t\n", c.Char, fg, bg, ok)
+	_, ok = d.Grid().At(1, 1)
+	fmt.Println(ok)
+	// Output: C 15 1 true
+	// false
+}
+
+func ExampleGrid_Set() {
+	data := []byte("H\x07i\x07!\x07")
+	d := binbump.NewDecoder(3, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	d.Grid().Set(1, 0, binbump.Cell{Char: 'o', Attr: 0x0e})
+	fmt.Print(d.Grid().Transcript())
+	// Output: Ho!
+}
+
+func ExampleGrid_Fill() {
+	// redact the phone number of a BBS advert
+	data := []byte{}
+	for _, r := range "CALL 555-0123 NOW" {
+		data = append(data, byte(r), 0x07)
+	}
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	g := d.Grid()
+	g.Fill(image.Rect(5, 0, 13, 1), binbump.Cell{Char: 0xdb, Attr: 0x00})
+	var b bytes.Buffer
+	if err := g.WriteBIN(&b); err != nil {
+		panic(err)
+	}
+	fmt.Print(g.Transcript())
+	fmt.Println(b.Len())
+	// Output: CALL NOW
+	// 160
+}
+
+func ExampleGrid_Reverse() {
+	data := []byte{0x41, 0x07, 0x42, 0x1e, 0x43, 0x8f}
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	g := d.Grid()
+	g.Reverse(image.Rect(0, 0, 80, 25))
+	for x := range 3 {
+		c, _ := g.At(x, 0)
+		fmt.Printf("%#02x ", c.Attr)
+	}
+	// Output: 0x70 0x69 0xf8
+}
+
+func ExampleGrid_Print() {
+	data := bytes.Repeat([]byte{0xb0, 0x01}, 20*2)
+	d := binbump.NewDecoder(20, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	g := d.Grid()
+	// the © copyright sign is not in Code Page 437
+	n := g.Print(2, 0, "© binbump\n½ size", 15, 1)
+	fmt.Println(n, "cells")
+	fmt.Print(g.Transcript())
+	// Output: 15 cells
+	// ? binbump
+	// ½ size
+}
+
+func ExampleGrid_SetBlank() {
+	// a full block with the same foreground and background colors is blank
+	data := []byte{0x41, 0x1f, 0xdb, 0x11, 0x20, 0x17, 0x42, 0x1f}
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	g := d.Grid()
+	g.SetBlank(func(c binbump.Cell) bool {
+		fg, bg := c.Colors()
+		return c.Char == 0x20 || c.Char == 0xdb && fg == bg
+	})
+	d.Optimize = true
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: <div><span style="color:#fff;background-color:#00a;">A  B
+	// </span></div>
+}
@@ -0,0 +1,167 @@
+package binbump
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+)
+
+const (
+	cellW = 8  // cell width in pixels of the raster renderer
+	cellH = 16 // cell height in pixels of the raster renderer
+)
+
+// Image returns the grid rendered as an image, with each cell drawn as 8 by 16 pixels.
+//
+// The text uses a built-in bitmap font of the ASCII characters, doubled in height,
+// with accented letters drawn as the base letter and any other missing character
+// drawn as a hollow box. The block, shade and box-drawing characters of the charset
+// are drawn as pixel-exact shapes, with the shades dithered.
+//
+// When a font is set by [Grid.SetFont], every character is drawn with the glyph of the
+// font instead, and each cell is the size of the glyphs.
+func (g *Grid) Image() *image.RGBA {
+	f := g.renderFont()
+	w, h := g.cellSize()
+	img := image.NewRGBA(image.Rect(0, 0, g.width*w, len(g.rows)*h))
+	for y, row := range g.rows {
+		for x, c := range row {
+			pt := image.Pt(x*w, y*h)
+			if f != nil {
+				g.drawGlyph(img, pt, c, f)
+				continue
+			}
+			g.drawCell(img, pt, c)
+		}
+	}
+	return img
+}
+
+// cellSize returns the width and height in pixels of a cell of the [Grid.Image].
+func (g *Grid) cellSize() (int, int) {
+	if f := g.renderFont(); f != nil {
+		return f.Width, f.Height
+	}
+	return cellW, cellH
+}
+
+// drawGlyph draws the cell using the glyph of the font, with the top-left corner at the point.
+func (g *Grid) drawGlyph(img *image.RGBA, pt image.Point, c Cell, f *Font) {
+	fg, bg := g.attrColors(c)
+	fgc, bgc := rgba(g.colors[fg]), rgba(g.colors[bg])
+	for y := range f.Height {
+		for x := range f.Width {
+			col := bgc
+			if f.pixel(c.Char, x, y) || (y == f.Height-1 && g.underline(c)) {
+				col = fgc
+			}
+			img.SetRGBA(pt.X+x, pt.Y+y, col)
+		}
+	}
+}
+
+// WritePNG writes to w the grid as a PNG image rendered by [Grid.Image].
+func (g *Grid) WritePNG(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	if err := png.Encode(w, g.Image()); err != nil {
+		return fmt.Errorf("write png: %w", err)
+	}
+	return nil
+}
+
+// drawCell draws the cell with the top-left corner at the point.
+func (g *Grid) drawCell(img *image.RGBA, pt image.Point, c Cell) {
+	fg, bg := g.attrColors(c)
+	fgc, bgc := rgba(g.colors[fg]), rgba(g.colors[bg])
+	cell := image.Rect(pt.X, pt.Y, pt.X+cellW, pt.Y+cellH)
+	draw.Draw(img, cell, image.NewUniform(bgc), image.Point{}, draw.Src)
+	fill := func(r image.Rectangle) {
+		draw.Draw(img, r.Add(pt).Intersect(cell), image.NewUniform(fgc), image.Point{}, draw.Src)
+	}
+	if g.underline(c) {
+		fill(image.Rect(0, cellH-1, cellW, cellH))
+	}
+	r := g.rune(c)
+	if blk, ok := blockElements[r]; ok {
+		rect := image.Rect(int(blk.x*cellW), int(blk.y*cellH),
+			int((blk.x+blk.w)*cellW), int((blk.y+blk.h)*cellH))
+		for py := rect.Min.Y; py < rect.Max.Y; py++ {
+			for px := rect.Min.X; px < rect.Max.X; px++ {
+				if dither(px, py, blk.shade) {
+					img.SetRGBA(pt.X+px, pt.Y+py, fgc)
+				}
+			}
+		}
+		return
+	}
+	if a, ok := boxDrawing[r]; ok {
+		drawArms(fill, a)
+		return
+	}
+	bits, ok := bitmap(r)
+	if !ok {
+		return
+	}
+	const rowH = cellH / len(bits)
+	for row, b := range bits {
+		for px := range cellW {
+			if b&(0x80>>px) != 0 {
+				fill(image.Rect(px, row*rowH, px+1, (row+1)*rowH))
+			}
+		}
+	}
+}
+
+// dither reports whether the pixel uses the foreground color of a shade,
+// where shade is the percentage of the foreground.
+func dither(x, y, shade int) bool {
+	const light, medium, dark = 25, 50, 75
+	switch shade {
+	case light:
+		return x%2 == 0 && y%2 == 0
+	case medium:
+		return (x+y)%2 == 0
+	case dark:
+		return x%2 != 0 || y%2 != 0
+	}
+	return true
+}
+
+// drawArms draws the lines of a box-drawing character using the fill function,
+// with the arms overlapping the center of the cell so that corners and junctions join.
+func drawArms(fill func(image.Rectangle), a arms) {
+	const cx, cy = cellW/2 - 1, cellH/2 - 1
+	vertical := func(weight uint8, y0, y1 int) {
+		switch weight {
+		case singleLine:
+			fill(image.Rect(cx, y0, cx+1, y1))
+		case doubleLine:
+			fill(image.Rect(cx-1, y0, cx, y1))
+			fill(image.Rect(cx+2, y0, cx+3, y1))
+		}
+	}
+	horizontal := func(weight uint8, x0, x1 int) {
+		switch weight {
+		case singleLine:
+			fill(image.Rect(x0, cy, x1, cy+1))
+		case doubleLine:
+			fill(image.Rect(x0, cy-1, x1, cy))
+			fill(image.Rect(x0, cy+2, x1, cy+3))
+		}
+	}
+	vertical(a.up, 0, cy+3)
+	vertical(a.down, cy-1, cellH)
+	horizontal(a.left, 0, cx+3)
+	horizontal(a.right, cx-1, cellW)
+}
+
+// rgba returns the color as an opaque color.RGBA.
+func rgba(c Color) color.RGBA {
+	r, g, b := c.RGB()
+	return color.RGBA{R: r, G: g, B: b, A: 0xff}
+}
@@ -0,0 +1,40 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_Image() {
+	data := []byte{0x48, 0x1e, 0x69, 0x1e, 0xdb, 0x04}
+	d := binbump.NewDecoder(3, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	img := d.Grid().Image()
+	fmt.Println(img.Bounds())
+	fmt.Println(img.At(7, 0), img.At(0, 0), img.At(16, 0))
+	// Output: (0,0)-(24,16)
+	// {0 0 170 255} {255 255 85 255} {170 0 0 255}
+}
+
+func ExampleGrid_WritePNG() {
+	data := []byte{0x48, 0x1e, 0x69, 0x1e}
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	var b bytes.Buffer
+	if err := d.Grid().WritePNG(&b); err != nil {
+		panic(err)
+	}
+	cfg, err := png.DecodeConfig(&b)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(cfg.Width, cfg.Height)
+	// Output: 16 16
+}
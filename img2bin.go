@@ -0,0 +1,129 @@
+package binbump
+
+import (
+	"image"
+	"math"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// imageChar is a block or shade character used by [FromImage], with the coverage
+// of the foreground color in the top-left, top-right, bottom-left and bottom-right
+// quarters of the cell.
+type imageChar struct {
+	code     byte
+	coverage [4]float64
+}
+
+// imageChars are the CP437 block and shade characters used by [FromImage].
+//
+//nolint:gochecknoglobals
+var imageChars = [...]imageChar{
+	{0x20, [4]float64{0, 0, 0, 0}},             // space
+	{0xdb, [4]float64{1, 1, 1, 1}},             // full block
+	{0xdf, [4]float64{1, 1, 0, 0}},             // upper half block
+	{0xdc, [4]float64{0, 0, 1, 1}},             // lower half block
+	{0xdd, [4]float64{1, 0, 1, 0}},             // left half block
+	{0xde, [4]float64{0, 1, 0, 1}},             // right half block
+	{0xb0, [4]float64{0.25, 0.25, 0.25, 0.25}}, // light shade
+	{0xb1, [4]float64{0.5, 0.5, 0.5, 0.5}},     // medium shade
+	{0xb2, [4]float64{0.75, 0.75, 0.75, 0.75}}, // dark shade
+}
+
+// FromImage converts the image to a grid of the width (columns), the inverse of [Grid.Image],
+// for artists and bots that create text mode screens from pictures. If width <= 0, 80 is used.
+//
+// The image is scaled to cells twice as tall as they are wide, matching the 8 by 16 pixel
+// characters of a VGA text mode. Each cell is quantized to the 16 foreground and 8 background
+// colors of the palette, using the CP437 block, half block or shade character, with the
+// colors that best match the average colors of the quarters of the cell.
+// The grid uses [charmap.CodePage437] and can be saved with [Grid.WriteBIN].
+func FromImage(img image.Image, width int, pal Palette) *Grid {
+	const columns, aspect = 80, 2
+	if width <= 0 {
+		width = columns
+	}
+	g := &Grid{charset: charmap.CodePage437, width: width}
+	g.setPalette(pal)
+	b := img.Bounds()
+	if b.Empty() {
+		return g
+	}
+	cellW := float64(b.Dx()) / float64(width)
+	cellH := cellW * aspect
+	height := max(1, int(math.Round(float64(b.Dy())/cellH)))
+	var palette [16][3]float64
+	for i, c := range g.colors {
+		r, gr, bl := c.RGB()
+		palette[i] = [3]float64{float64(r), float64(gr), float64(bl)}
+	}
+	g.rows = make([][]Cell, height)
+	for y := range height {
+		row := make([]Cell, width)
+		for x := range width {
+			rect := [4]image.Rectangle{}
+			for q := range rect {
+				left := float64(b.Min.X) + (float64(x)+float64(q%2)/2)*cellW
+				top := float64(b.Min.Y) + (float64(y)+float64(q/2)/2)*cellH
+				rect[q] = image.Rect(int(left), int(top), int(left+cellW/2), int(top+cellH/2))
+			}
+			var quarters [4][3]float64
+			for q, r := range rect {
+				quarters[q] = average(img, r)
+			}
+			row[x] = matchCell(quarters, &palette)
+		}
+		g.rows[y] = row
+	}
+	return g
+}
+
+// average returns the average RGB color of the pixels within the rectangle of the image,
+// where a rectangle smaller than a pixel uses the pixel at its top-left corner.
+func average(img image.Image, r image.Rectangle) [3]float64 {
+	r = r.Intersect(img.Bounds())
+	if r.Empty() {
+		r = image.Rect(r.Min.X, r.Min.Y, r.Min.X+1, r.Min.Y+1).Intersect(img.Bounds())
+	}
+	var sum [3]float64
+	n := 0.0
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			cr, cg, cb, _ := img.At(x, y).RGBA()
+			const shift = 8
+			sum[0] += float64(cr >> shift)
+			sum[1] += float64(cg >> shift)
+			sum[2] += float64(cb >> shift)
+			n++
+		}
+	}
+	if n == 0 {
+		return sum
+	}
+	return [3]float64{sum[0] / n, sum[1] / n, sum[2] / n}
+}
+
+// matchCell returns the cell of the character and colors that best match the average
+// colors of the quarters of a cell, with the least squared error.
+func matchCell(quarters [4][3]float64, palette *[16][3]float64) Cell {
+	const backgrounds = 8
+	best, bestErr := Cell{Char: ' ', Attr: 0x07}, math.Inf(1)
+	for _, ch := range imageChars {
+		for fg := range palette {
+			for bg := range backgrounds {
+				e := 0.0
+				for q, cov := range ch.coverage {
+					for i := range 3 {
+						v := palette[fg][i]*cov + palette[bg][i]*(1-cov)
+						d := v - quarters[q][i]
+						e += d * d
+					}
+				}
+				if e < bestErr {
+					best, bestErr = Cell{Char: ch.code, Attr: attribute(uint8(fg), uint8(bg))}, e //nolint:gosec
+				}
+			}
+		}
+	}
+	return best
+}
@@ -0,0 +1,21 @@
+package binbump_test
+
+import (
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleFromImage() {
+	src := binbump.NewGrid(3, 1, binbump.StandardCGA, nil)
+	src.Set(0, 0, binbump.Cell{Char: 0xdf, Attr: 0x1e})
+	src.Set(1, 0, binbump.Cell{Char: 0xdd, Attr: 0x02})
+	src.Set(2, 0, binbump.Cell{Char: 0xb2, Attr: 0x0e})
+	g := binbump.FromImage(src.Image(), 3, binbump.StandardCGA)
+	for _, c := range g.Cells() {
+		fmt.Printf("%02x %02x\n", c.Char, c.Attr)
+	}
+	// Output: df 1e
+	// dd 02
+	// b2 0e
+}
@@ -0,0 +1,58 @@
+package binbump
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+var (
+	_ io.ReaderFrom = (*Decoder)(nil)
+	_ io.WriterTo   = (*Decoder)(nil)
+)
+
+// ReadFrom implements [io.ReaderFrom] by reading the binary dump from r into the grid,
+// as done by [Decoder.Read]. The return int64 is the number of bytes decoded.
+func (d *Decoder) ReadFrom(r io.Reader) (int64, error) {
+	start := d.read
+	err := d.Read(r)
+	return int64(d.read - start), err
+}
+
+// WriteTo implements [io.WriterTo] by writing the HTML fragment to w,
+// as done by [Decoder.Write]. The return int64 is the number of bytes written.
+func (d *Decoder) WriteTo(w io.Writer) (int64, error) {
+	if err := d.Write(w); err != nil {
+		return d.stats.Bytes, err
+	}
+	return d.stats.Bytes, nil
+}
+
+// Renderer writes the grid of the Decoder to w in an output format,
+// such as the method expression (*Decoder).Write for the HTML fragment.
+type Renderer func(d *Decoder, w io.Writer) error
+
+// Output is a writer and the renderer of its format used by [Decoder.WriteAll].
+// A nil Render writes the HTML fragment.
+type Output struct {
+	Writer io.Writer
+	Render Renderer
+}
+
+// WriteAll renders the grid to every output, so that one decoded screen can
+// produce multiple formats, such as a HTML page and the text of a search index,
+// without reading the data again. The outputs are written in order and a failed
+// output does not stop the others, with the errors joined in the return error.
+func (d *Decoder) WriteAll(outputs ...Output) error {
+	var errs []error
+	for i, out := range outputs {
+		render := out.Render
+		if render == nil {
+			render = (*Decoder).Write
+		}
+		if err := render(d, out.Writer); err != nil {
+			errs = append(errs, fmt.Errorf("write all output %d: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
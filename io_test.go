@@ -0,0 +1,65 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleDecoder_ReadFrom() {
+	file, err := os.Open("testdata/test1.bin")
+	if err != nil {
+		panic(err)
+	}
+	defer file.Close()
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil)
+	var rf io.ReaderFrom = d
+	n, err := rf.ReadFrom(file)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%d bytes decoded", n)
+	// Output: 4128 bytes decoded
+}
+
+func ExampleDecoder_WriteTo() {
+	data := []byte{0x41, 0x00, 0x42, 0x08}
+	d := binbump.NewDecoder(0, 0, binbump.StandardCGA, nil)
+	if _, err := d.ReadFrom(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	var b bytes.Buffer
+	var wt io.WriterTo = d
+	n, err := wt.WriteTo(&b)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%d bytes written\n%q", n, b.String())
+	// Output: 124 bytes written
+	// "<div><span style=\"color:#000;background-color:#000;\">A</span><span style=\"color:#555;background-color:#000;\">B</span>\n</div>"
+}
+
+func ExampleDecoder_WriteAll() {
+	data := []byte{0x48, 0x07, 0x69, 0x07}
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	var page, index bytes.Buffer
+	text := func(d *binbump.Decoder, w io.Writer) error {
+		_, err := io.WriteString(w, d.Grid().Transcript())
+		return err
+	}
+	err := d.WriteAll(
+		binbump.Output{Writer: &page},
+		binbump.Output{Writer: &index, Render: text},
+	)
+	fmt.Println(err)
+	fmt.Printf("%q\n%q", page.String(), index.String())
+	// Output: <nil>
+	// "<div><span style=\"color:#aaa;background-color:#000;\">Hi</span>\n</div>"
+	// "Hi\n"
+}
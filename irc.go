@@ -0,0 +1,63 @@
+package binbump
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// mircColors maps the 4-bit color codes to the nearest of the 16 standard mIRC colors.
+//
+//nolint:gochecknoglobals
+var mircColors = [16]uint8{
+	1,  // black
+	2,  // blue -> navy
+	3,  // green
+	10, // cyan -> teal
+	5,  // red -> maroon
+	6,  // magenta -> purple
+	7,  // brown -> orange
+	15, // gray -> light grey
+	14, // intense black -> grey
+	12, // intense blue -> light blue
+	9,  // intense green -> light green
+	11, // intense cyan -> light cyan
+	4,  // intense red -> red
+	13, // intense magenta -> pink
+	8,  // yellow
+	0,  // white
+}
+
+// WriteIRC writes to w the grid as UTF-8 text using mIRC color codes,
+// for sending to IRC channels. The 16 colors are approximated by the standard
+// mIRC colors, as IRC clients use their own palettes.
+// Each row is a line that ends with a formatting reset.
+//
+// Many IRC networks limit messages to 512 bytes, so wide or colorful rows may need to be
+// split by the sender.
+func (g *Grid) WriteIRC(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	const colorCode, reset = 0x03, 0x0f
+	out := bufio.NewWriter(w)
+	for _, row := range g.rows {
+		attr := -1
+		for _, c := range row {
+			fg, bg := g.attrColors(c)
+			// the color pairs use two digits so that digits in the text are not
+			// confused with the color code
+			if int(c.Attr) != attr {
+				fmt.Fprintf(out, "%c%02d,%02d", colorCode, mircColors[fg], mircColors[bg])
+				attr = int(c.Attr)
+			}
+			out.WriteRune(textRune(g.rune(c)))
+		}
+		out.WriteByte(reset)
+		out.WriteByte('\n')
+	}
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("write irc flush: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_WriteIRC() {
+	data := []byte{0x48, 0x1e, 0x69, 0x1e, 0x21, 0x07, 0xdb, 0x04}
+	d := binbump.NewDecoder(0, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	var b bytes.Buffer
+	if err := d.Grid().WriteIRC(&b); err != nil {
+		panic(err)
+	}
+	fmt.Printf("%q", b.String())
+	// Output: "\x0308,02Hi\x0315,01!\x0305,01█\x0f\n"
+}
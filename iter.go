@@ -0,0 +1,44 @@
+package binbump
+
+import "iter"
+
+// Point is the zero-based column (X) and row (Y) position of a cell in a grid.
+type Point struct {
+	X, Y int
+}
+
+// Width returns the number of columns of the grid.
+func (g *Grid) Width() int {
+	return g.width
+}
+
+// Height returns the number of rows of the grid.
+func (g *Grid) Height() int {
+	return len(g.rows)
+}
+
+// Rows returns an iterator over the zero-based row numbers and the cells of each row.
+// The cells are shared with the grid and must not be modified.
+func (g *Grid) Rows() iter.Seq2[int, []Cell] {
+	return func(yield func(int, []Cell) bool) {
+		for y, row := range g.rows {
+			if !yield(y, row) {
+				return
+			}
+		}
+	}
+}
+
+// Cells returns an iterator over the position and value of every cell,
+// from left to right and top to bottom.
+func (g *Grid) Cells() iter.Seq2[Point, Cell] {
+	return func(yield func(Point, Cell) bool) {
+		for y, row := range g.rows {
+			for x, c := range row {
+				if !yield(Point{X: x, Y: y}, c) {
+					return
+				}
+			}
+		}
+	}
+}
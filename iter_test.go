@@ -0,0 +1,36 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_Rows() {
+	data := []byte{0x41, 0x07, 0x42, 0x07, 0x43, 0x0f}
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	for y, row := range d.Grid().Rows() {
+		fmt.Println(y, len(row))
+	}
+	// Output: 0 2
+	// 1 1
+}
+
+func ExampleGrid_Cells() {
+	data := []byte{0x41, 0x07, 0x42, 0x07, 0x43, 0x0f}
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	for p, c := range d.Grid().Cells() {
+		fg, bg := c.Colors()
+		fmt.Printf("%+v %c %d %d\n", p, c.Char, fg, bg)
+	}
+	// Output: {X:0 Y:0} A 7 0
+	// {X:1 Y:0} B 7 0
+	// {X:0 Y:1} C 15 0
+}
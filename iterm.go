@@ -0,0 +1,28 @@
+package binbump
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+)
+
+// WriteITerm2 writes to w the grid as a PNG image rendered by [Grid.Image], using the
+// OSC 1337 inline image protocol of iTerm2, which is also supported by WezTerm,
+// so the screen can be previewed in the terminal.
+func (g *Grid) WriteITerm2(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	var img bytes.Buffer
+	if err := g.WritePNG(&img); err != nil {
+		return err
+	}
+	cw, ch := g.cellSize()
+	_, err := fmt.Fprintf(w, "\x1b]1337;File=inline=1;size=%d;width=%dpx;height=%dpx;preserveAspectRatio=1:%s\a\n",
+		img.Len(), g.width*cw, len(g.rows)*ch, base64.StdEncoding.EncodeToString(img.Bytes()))
+	if err != nil {
+		return fmt.Errorf("write iterm2: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package binbump_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image/png"
+	"strings"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_WriteITerm2() {
+	data := []byte{0x48, 0x1e, 0x69, 0x1e}
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	var b strings.Builder
+	if err := d.Grid().WriteITerm2(&b); err != nil {
+		panic(err)
+	}
+	header, payload, _ := strings.Cut(b.String(), ":")
+	img, err := png.DecodeConfig(base64.NewDecoder(base64.StdEncoding, strings.NewReader(payload)))
+	if err != nil {
+		panic(err)
+	}
+	protocol, _, _ := strings.Cut(header, ";size=")
+	fmt.Printf("%q\n%dx%d", protocol, img.Width, img.Height)
+	// Output: "\x1b]1337;File=inline=1"
+	// 16x16
+}
@@ -0,0 +1,120 @@
+package binbump
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// jsonCell is the JSON representation of a cell.
+type jsonCell struct {
+	Ch    string `json:"ch"`
+	FG    uint8  `json:"fg"`
+	BG    uint8  `json:"bg"`
+	Blink bool   `json:"blink"`
+}
+
+// jsonGrid is the JSON representation of a grid.
+type jsonGrid struct {
+	Width int          `json:"width"`
+	Rows  [][]jsonCell `json:"rows"`
+}
+
+// jsonRow is the JSON Lines representation of a row.
+type jsonRow struct {
+	Y     int        `json:"y"`
+	Cells []jsonCell `json:"cells"`
+}
+
+// MarshalJSON returns the grid as a JSON object containing the width
+// and the rows of cells, where each cell is an object of the decoded character,
+// the foreground and background color codes, and the blink bit.
+//
+//	{"width":80,"rows":[[{"ch":"A","fg":7,"bg":0,"blink":false}]]}
+func (g *Grid) MarshalJSON() ([]byte, error) {
+	v := jsonGrid{
+		Width: g.width,
+		Rows:  make([][]jsonCell, 0, len(g.rows)),
+	}
+	for _, row := range g.rows {
+		v.Rows = append(v.Rows, g.jsonCells(row))
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("grid marshal json: %w", err)
+	}
+	return b, nil
+}
+
+// jsonCells returns the JSON representation of the row of cells.
+func (g *Grid) jsonCells(row []Cell) []jsonCell {
+	cells := make([]jsonCell, 0, len(row))
+	for _, c := range row {
+		fg, bg := c.Colors()
+		cells = append(cells, jsonCell{
+			Ch:    string(g.rune(c)),
+			FG:    fg,
+			BG:    bg,
+			Blink: c.Blink(),
+		})
+	}
+	return cells
+}
+
+// WriteJSON writes to w the JSON cell grid of the binary dump found in the Reader.
+// It assumes the Reader is using IBM Code Page 437 encoding.
+//
+// The return int64 is the number of bytes written.
+func WriteJSON(r io.Reader, w io.Writer) (int64, error) {
+	if r == nil {
+		return 0, ErrReader
+	}
+	d := NewDecoder(0, 0, StandardCGA, nil)
+	if err := d.Read(r); err != nil {
+		return 0, err
+	}
+	b, err := d.Grid().MarshalJSON()
+	if err != nil {
+		return 0, err
+	}
+	i, err := bytes.NewReader(b).WriteTo(w)
+	if err != nil {
+		return 0, fmt.Errorf("write json: %w", err)
+	}
+	return i, nil
+}
+
+// WriteJSONL reads the binary dump from r and writes to w each row in the JSON Lines
+// format, as soon as the row is decoded. Each line is a JSON object of the row number
+// and the cells, which are the same as those of [Grid.MarshalJSON].
+//
+//	{"y":0,"cells":[{"ch":"A","fg":7,"bg":0,"blink":false}]}
+//
+// The written rows are discarded, so that the memory use does not grow with the size
+// of the data, and pipelines can process huge collections of screens.
+// This leaves the grid of the Decoder empty.
+func (d *Decoder) WriteJSONL(r io.Reader, w io.Writer) error {
+	if r == nil {
+		return ErrReader
+	}
+	if w == nil {
+		w = io.Discard
+	}
+	out := bufio.NewWriter(w)
+	enc := json.NewEncoder(out)
+	d.rowFunc = func(y int, row []Cell) error {
+		if err := enc.Encode(jsonRow{Y: y, Cells: d.grid.jsonCells(row)}); err != nil {
+			return fmt.Errorf("write jsonl: %w", err)
+		}
+		d.grid.rows = d.grid.rows[:0]
+		return nil
+	}
+	defer func() { d.rowFunc = nil }()
+	err := d.Read(r)
+	if ferr := out.Flush(); ferr != nil && err == nil {
+		err = fmt.Errorf("write jsonl flush: %w", ferr)
+	}
+	return err
+}
@@ -0,0 +1,40 @@
+package binbump_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_MarshalJSON() {
+	data := []byte{0x41, 0x07, 0xdb, 0x8c}
+	d := binbump.NewDecoder(0, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	p, _ := json.Marshal(d.Grid())
+	fmt.Printf("%s", p)
+	// Output: {"width":160,"rows":[[{"ch":"A","fg":7,"bg":0,"blink":false},{"ch":"█","fg":12,"bg":0,"blink":true}]]}
+}
+
+func ExampleWriteJSON() {
+	data := []byte{0x41, 0x00, 0x42, 0x08}
+	r := bytes.NewReader(data)
+	cnt, _ := binbump.WriteJSON(r, os.Stdout)
+	fmt.Printf("\n%d bytes written", cnt)
+	// Output: {"width":160,"rows":[[{"ch":"A","fg":0,"bg":0,"blink":false},{"ch":"B","fg":8,"bg":0,"blink":false}]]}
+	// 102 bytes written
+}
+
+func ExampleDecoder_WriteJSONL() {
+	data := []byte{0x41, 0x07, 0x42, 0x07, 0x43, 0x1e}
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.WriteJSONL(bytes.NewReader(data), os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: {"y":0,"cells":[{"ch":"A","fg":7,"bg":0,"blink":false},{"ch":"B","fg":7,"bg":0,"blink":false}]}
+	// {"y":1,"cells":[{"ch":"C","fg":14,"bg":1,"blink":false}]}
+}
@@ -0,0 +1,43 @@
+package binbump
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+)
+
+// WriteKitty writes to w the grid as a PNG image rendered by [Grid.Image], using the
+// graphics protocol of the kitty terminal, so the screen can be previewed in the terminal.
+// The image is transmitted and displayed at the cursor in chunks of 4096 bytes.
+func (g *Grid) WriteKitty(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	var img bytes.Buffer
+	if err := g.WritePNG(&img); err != nil {
+		return err
+	}
+	const chunk = 4096 // the maximum size of the base64 payload of a chunk
+	payload := base64.StdEncoding.EncodeToString(img.Bytes())
+	out := bufio.NewWriter(w)
+	for i := 0; i < len(payload); i += chunk {
+		more := 0
+		if i+chunk < len(payload) {
+			more = 1
+		}
+		if i == 0 {
+			fmt.Fprintf(out, "\x1b_Ga=T,f=100,m=%d;", more)
+		} else {
+			fmt.Fprintf(out, "\x1b_Gm=%d;", more)
+		}
+		out.WriteString(payload[i:min(i+chunk, len(payload))])
+		out.WriteString("\x1b\\")
+	}
+	out.WriteByte('\n')
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("write kitty flush: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_WriteKitty() {
+	data := bytes.Repeat([]byte{0xb1, 0x1e}, 80*25)
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	var b strings.Builder
+	if err := d.Grid().WriteKitty(&b); err != nil {
+		panic(err)
+	}
+	chunks := strings.Split(strings.TrimSpace(b.String()), "\x1b\\")
+	first, _, _ := strings.Cut(chunks[0], ";")
+	fmt.Printf("%q %v", first, len(chunks) > 2)
+	// Output: "\x1b_Ga=T,f=100,m=1" true
+}
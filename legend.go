@@ -0,0 +1,97 @@
+package binbump
+
+import (
+	"bufio"
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// ColorUsage is a palette entry used by the cells of a grid.
+type ColorUsage struct {
+	Code       uint8 // Code is the color code, between 0 and 15.
+	Color      Color // Color is the color of the colorset.
+	Foreground int   // Foreground is the number of cells with visible text of the color.
+	Background int   // Background is the number of cells with the background color.
+}
+
+// ColorUsage returns the palette entries used by the grid, ordered from the most to the
+// least used, where the foreground colors of the [Grid.Blank] cells are not counted as
+// their text is not visible. For the monochrome display adapter, the rendered colors
+// of the attributes are counted.
+func (g *Grid) ColorUsage() []ColorUsage {
+	var fgs, bgs [16]int
+	for _, row := range g.rows {
+		for _, c := range row {
+			fg, bg := g.attrColors(c)
+			bgs[bg]++
+			if !g.Blank(c) || g.underline(c) {
+				fgs[fg]++
+			}
+		}
+	}
+	usage := []ColorUsage{}
+	for i := range fgs {
+		if fgs[i] == 0 && bgs[i] == 0 {
+			continue
+		}
+		usage = append(usage, ColorUsage{
+			Code:       uint8(i), //nolint:gosec
+			Color:      g.colors[i],
+			Foreground: fgs[i],
+			Background: bgs[i],
+		})
+	}
+	slices.SortStableFunc(usage, func(a, b ColorUsage) int {
+		return cmp.Compare(b.Foreground+b.Background, a.Foreground+a.Background)
+	})
+	return usage
+}
+
+// WriteLegend writes to w an HTML list of the palette entries used by the grid, with
+// a swatch, the hexadecimal color and the number of foreground and background cells of
+// each entry, which gallery pages can display alongside the artwork.
+// The list is ordered by the [Grid.ColorUsage].
+func (g *Grid) WriteLegend(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	out := bufio.NewWriter(w)
+	out.WriteString(`<ul class="legend" style="list-style:none;padding:0;">` + "\n")
+	for _, u := range g.ColorUsage() {
+		fmt.Fprintf(out, `<li data-code="%d"><span style="display:inline-block;width:1em;height:1em;`+
+			`background-color:#%s;"></span> <code>#%s</code> %d fg, %d bg</li>`+"\n",
+			u.Code, u.Color.hex(), u.Color.hex(), u.Foreground, u.Background)
+	}
+	out.WriteString("</ul>\n")
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("write legend flush: %w", err)
+	}
+	return nil
+}
+
+// WriteLegendSVG writes to w the legend of [Grid.WriteLegend] as an SVG image,
+// with a row 16 units high for each palette entry.
+func (g *Grid) WriteLegendSVG(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	const width, rowH, swatch = 200, 16, 12
+	usage := g.ColorUsage()
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" `+
+		`font-family="monospace" font-size="%d">`+"\n",
+		width, len(usage)*rowH, width, len(usage)*rowH, svgFontSize)
+	for i, u := range usage {
+		top := i * rowH
+		fmt.Fprintf(out, `<rect x="2" y="%d" width="%d" height="%d" fill="#%s" stroke="#808080"/>`+
+			`<text x="%d" y="%d">#%s %d fg, %d bg</text>`+"\n",
+			top+2, swatch, swatch, u.Color.hex(), swatch+6, top+svgBaseline, u.Color.hex(), u.Foreground, u.Background) //nolint:mnd
+	}
+	out.WriteString("</svg>\n")
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("write legend svg flush: %w", err)
+	}
+	return nil
+}
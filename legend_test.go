@@ -0,0 +1,38 @@
+package binbump_test
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_ColorUsage() {
+	d := binbump.NewDecoder(3, 0, binbump.StandardCGA, nil)
+	if err := d.Read(strings.NewReader("H\x07i\x1e \x1e")); err != nil {
+		log.Fatal(err)
+	}
+	for _, u := range d.Grid().ColorUsage() {
+		fmt.Println(u.Code, u.Color, u.Foreground, u.Background)
+	}
+	// Output: 1 00a 0 2
+	// 0 000 0 1
+	// 7 aaa 1 0
+	// 14 ff5 1 0
+}
+
+func ExampleGrid_WriteLegend() {
+	d := binbump.NewDecoder(3, 0, binbump.StandardCGA, nil)
+	if err := d.Read(strings.NewReader("H\x07i\x07")); err != nil {
+		log.Fatal(err)
+	}
+	if err := d.Grid().WriteLegend(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+	// Output: <ul class="legend" style="list-style:none;padding:0;">
+	// <li data-code="0"><span style="display:inline-block;width:1em;height:1em;background-color:#000000;"></span> <code>#000000</code> 0 fg, 2 bg</li>
+	// <li data-code="7"><span style="display:inline-block;width:1em;height:1em;background-color:#aaaaaa;"></span> <code>#aaaaaa</code> 2 fg, 0 bg</li>
+	// </ul>
+}
@@ -0,0 +1,86 @@
+package binbump
+
+import (
+	"fmt"
+	"io"
+)
+
+// LimitError is returned when the data exceeds a limit of [WithLimits].
+type LimitError struct {
+	Limit string // Limit is the name of the exceeded limit, either "bytes", "columns" or "rows".
+	Max   int64  // Max is the maximum of the limit.
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("data exceeds the limit of %d %s", e.Max, e.Limit)
+}
+
+// WithLimits sets the maximum number of bytes, columns and rows of the data decoded by
+// [Decoder.Read], so services accepting uploads from untrusted users cannot be made to
+// allocate huge grids. A value <= 0 is no limit.
+//
+// A width of the Decoder beyond the columns returns a [LimitError], and reading beyond
+// the bytes or rows stops with a [DecodeError] of the position that wraps a [LimitError],
+// leaving the grid with the rows decoded before the limit. Unlike the maxRows argument
+// of [NewDecoder], which silently discards the rows, exceeding the rows is an error.
+func WithLimits(maxBytes int64, maxColumns, maxRows int) Option {
+	return func(d *Decoder) {
+		d.limits = limits{bytes: maxBytes, columns: maxColumns, rows: maxRows}
+	}
+}
+
+// limits are the maximum bytes, columns and rows of the data.
+type limits struct {
+	bytes   int64
+	columns int
+	rows    int
+}
+
+// limitColumns returns a LimitError when the width of the Decoder exceeds the columns limit.
+func (d *Decoder) limitColumns() error {
+	if d.limits.columns > 0 && d.columns > d.limits.columns {
+		return &LimitError{Limit: "columns", Max: int64(d.limits.columns)}
+	}
+	return nil
+}
+
+// limitRows returns a LimitError when the next cell exceeds the rows limit.
+func (d *Decoder) limitRows() error {
+	if d.limits.rows > 0 && d.row > d.limits.rows {
+		return &LimitError{Limit: "rows", Max: int64(d.limits.rows)}
+	}
+	return nil
+}
+
+// limitReader returns r limited to the bytes limit, so that the data is never read
+// beyond the limit, such as by [io.ReadAll].
+func (d *Decoder) limitReader(r io.Reader) io.Reader {
+	if d.limits.bytes <= 0 {
+		return r
+	}
+	return &limitedReader{r: r, n: d.limits.bytes, max: d.limits.bytes}
+}
+
+// limitedReader reads from r until n bytes remain, and then returns a LimitError
+// if there is more data.
+type limitedReader struct {
+	r   io.Reader
+	n   int64 // n is the number of bytes remaining
+	max int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.n <= 0 {
+		var b [1]byte
+		if n, err := l.r.Read(b[:]); n == 0 {
+			return 0, err //nolint:wrapcheck
+		}
+		return 0, &LimitError{Limit: "bytes", Max: l.max}
+	}
+	if int64(len(p)) > l.n {
+		p = p[:l.n]
+	}
+	n, err := l.r.Read(p)
+	l.n -= int64(n)
+	return n, err //nolint:wrapcheck
+}
@@ -0,0 +1,48 @@
+package binbump_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleWithLimits() {
+	data := binbump.Generate(binbump.Spec{Pattern: binbump.CharTable, Width: 80, Height: 100})
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil, binbump.WithLimits(1<<20, 160, 50))
+	err := d.Read(bytes.NewReader(data))
+	var le *binbump.LimitError
+	if errors.As(err, &le) {
+		fmt.Println(le.Limit, le.Max, d.RowsDecoded())
+	}
+	fmt.Println(err)
+	// Output: rows 50 50
+	// offset 8000 (row 50, col 0): data exceeds the limit of 50 rows
+}
+
+func ExampleWithLimits_bytes() {
+	data := binbump.Generate(binbump.Spec{Pattern: binbump.Gradient, Width: 80, Height: 25})
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil, binbump.WithLimits(1000, 0, 0))
+	err := d.Read(bytes.NewReader(data))
+	fmt.Println(err, d.BytesRead())
+	// Output: offset 1000 (row 6, col 20): decoder scan: data exceeds the limit of 1000 bytes 1000
+}
+
+func ExampleWithLimits_errorPolicy() {
+	data := binbump.Generate(binbump.Spec{Pattern: binbump.Gradient, Width: 80, Height: 25})
+	for _, opt := range []binbump.Option{
+		binbump.WithErrorPolicy(binbump.ReplaceWithDefault),
+		binbump.WithIgnoreScanErrors(),
+		binbump.WithTrimMetadata(),
+	} {
+		d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil, binbump.WithLimits(1000, 0, 0), opt)
+		err := d.Read(bytes.NewReader(data))
+		var de *binbump.DecodeError
+		var le *binbump.LimitError
+		fmt.Println(errors.As(err, &de), errors.As(err, &le), de.Offset)
+	}
+	// Output: true true 1000
+	// true true 1000
+	// true true 1000
+}
@@ -0,0 +1,63 @@
+package binbump
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// linkPattern matches the URLs, FTP addresses and telnet://host:port strings
+// commonly found in BBS advertisements.
+//
+//nolint:gochecknoglobals
+var linkPattern = regexp.MustCompile(`(?i)\b(?:(?:https?|ftp|telnet)://[^\s<>"'` + "`" +
+	`]+|www\.[a-z0-9-]+\.[^\s<>"'` + "`" + `]+|ftp\.[a-z0-9-]+\.[a-z0-9.-]+)`)
+
+// link is the range of cells of a hyperlink in a row, from start to end exclusive.
+type link struct {
+	start, end int
+	href       string
+}
+
+// links returns the hyperlinks found in the text of the row of cells.
+func (g *Grid) links(row []Cell) []link {
+	var sb strings.Builder
+	cells := make([]int, 0, len(row)) // cells are the byte offsets of each cell
+	for _, c := range row {
+		cells = append(cells, sb.Len())
+		sb.WriteRune(textRune(g.rune(c)))
+	}
+	s := sb.String()
+	cell := func(offset int) int {
+		for i, n := range cells {
+			if n >= offset {
+				return i
+			}
+		}
+		return len(cells)
+	}
+	links := []link{}
+	for _, m := range linkPattern.FindAllStringIndex(s, -1) {
+		text := strings.TrimRight(s[m[0]:m[1]], ".,;:!?)]")
+		href := text
+		switch lower := strings.ToLower(text); {
+		case strings.HasPrefix(lower, "www."):
+			href = "http://" + text
+		case strings.HasPrefix(lower, "ftp."):
+			href = "ftp://" + text
+		}
+		links = append(links, link{
+			start: cell(m[0]),
+			end:   cell(m[0] + len(text)),
+			href:  href,
+		})
+	}
+	return links
+}
+
+// anchor writes the opening tag of an a element with the href attribute.
+func (w *htmlWriter) anchor(href string) {
+	w.WriteString(`<a href="`)
+	w.WriteString(html.EscapeString(href))
+	w.WriteString(`">`)
+}
@@ -0,0 +1,35 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleDecoder_Write_links() {
+	g := binbump.NewGrid(29, 1, binbump.StandardCGA, nil)
+	g.Print(0, 0, "Call telnet://bbs.example:23.", 15, 1)
+	var b bytes.Buffer
+	if err := g.WriteBIN(&b); err != nil {
+		panic(err)
+	}
+	d := binbump.NewDecoder(29, 0, binbump.StandardCGA, nil)
+	if err := d.Read(&b); err != nil {
+		panic(err)
+	}
+	d.Links = true
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	d.Optimize = true
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: <div><span style="color:#fff;background-color:#00a;">Call </span><a href="telnet://bbs.example:23"><span style="color:#fff;background-color:#00a;">telnet://bbs.example:23</span></a><span style="color:#fff;background-color:#00a;">.</span>
+	// </div>
+	// <div><span style="color:#fff;background-color:#00a;">Call </span><a href="telnet://bbs.example:23"><span style="color:#fff;background-color:#00a;">telnet://bbs.example:23</span></a><span style="color:#fff;background-color:#00a;">.
+	// </span></div>
+}
@@ -0,0 +1,72 @@
+package binbump
+
+import "strconv"
+
+// writeMinified writes the opening tag of the outer element with the style of the
+// most common attribute, and removes the styles that match it and its background,
+// so that these cells are written as text without span elements.
+// As the rows are not separated by newlines, the element is sized to the width of the grid
+// and wraps the text at every cell.
+func (d *Decoder) writeMinified(w *htmlWriter) {
+	if w.sep == Newline {
+		w.sep = NoSeparator
+	}
+	attr := d.dominantAttr(w)
+	style := w.styles[attr]
+	_, bg := d.grid.attrColors(Cell{Attr: attr})
+	div := d.backgroundStyle() + style
+	if w.sep == NoSeparator {
+		div += "width:" + strconv.Itoa(d.grid.width) + "ch;white-space:pre-wrap;word-break:break-all;"
+	}
+	d.openElement(w, div+d.fontStyle())
+	for i, s := range w.styles {
+		if s == style {
+			w.styles[i] = ""
+		}
+	}
+	w.bgStyles[bg] = ""
+}
+
+// dominantAttr returns the attribute with the style used by the most cells of the grid,
+// where attributes that share a style, such as with and without the blink bit, are counted
+// together. In the Optimize mode, the [Grid.Blank] cells only need a background color,
+// so they are counted for every attribute with the background color.
+// A tie or an empty grid returns the default gray on black attribute.
+func (d *Decoder) dominantAttr(w *htmlWriter) byte {
+	const defaultAttr = 0x07
+	optimize := d.Optimize && !d.Debug && !d.Trace
+	var counts [256]int
+	var bgs [16]int
+	for _, row := range d.grid.rows {
+		for _, c := range row {
+			if optimize && d.grid.Blank(c) && !d.grid.underline(c) {
+				_, bg := d.grid.attrColors(c)
+				bgs[bg]++
+				continue
+			}
+			counts[c.Attr]++
+		}
+	}
+	styles := map[string]int{}
+	for i, n := range counts {
+		styles[w.styles[i]] += n
+	}
+	score := func(attr byte) int {
+		_, bg := d.grid.attrColors(Cell{Attr: attr})
+		return styles[w.styles[attr]] + bgs[bg]
+	}
+	best := byte(defaultAttr)
+	for i := range counts {
+		if score(byte(i)) > score(best) { //nolint:gosec
+			best = byte(i) //nolint:gosec
+		}
+	}
+	return best
+}
+
+// bare reports whether the text of the style is written without a span element,
+// which is when the style is empty, such as in Minify mode or for the blank cells
+// with the Background color.
+func (w *htmlWriter) bare(style string) bool {
+	return style == ""
+}
@@ -0,0 +1,42 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleDecoder_Write_minify() {
+	data := []byte{0x41, 0x07, 0x42, 0x1f, 0x43, 0x07, 0x20, 0x07}
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	d.Minify = true
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	fmt.Println(d.Stats().Spans, "span")
+	// Output: <div style="color:#aaa;background-color:#000;width:2ch;white-space:pre-wrap;word-break:break-all;">A<span style="color:#fff;background-color:#00a;">B</span>C </div>
+	// 1 span
+}
+
+func ExampleDecoder_Write_minifyDominant() {
+	// a screen of mostly white on blue text
+	data := []byte{0x41, 0x1f, 0x42, 0x1f, 0x43, 0x07, 0x20, 0x1f}
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	d.Minify = true
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	fmt.Println(d.Stats().Spans, "span")
+	// Output: <div style="color:#fff;background-color:#00a;width:2ch;white-space:pre-wrap;word-break:break-all;">AB<span style="color:#aaa;background-color:#000;">C</span> </div>
+	// 1 span
+}
@@ -0,0 +1,90 @@
+package binbump
+
+import "strconv"
+
+// Mode is a text mode screen of the IBM PC video adapters, used by [WithMode]
+// instead of the width and row limit arguments of [NewDecoder].
+type Mode uint
+
+const (
+	Mode80x25  Mode = iota // Mode80x25 is the VGA 80 columns by 25 rows text mode.
+	Mode80x50              // Mode80x50 is the VGA 80 columns by 50 rows text mode.
+	Mode40x25              // Mode40x25 is the VGA 40 columns by 25 rows text mode.
+	Mode132x43             // Mode132x43 is the VESA SVGA 132 columns by 43 rows text mode.
+)
+
+// screenModes are the columns, rows, character cell size in pixels and SAUCE font name of the modes.
+//
+//nolint:gochecknoglobals
+var screenModes = [...]struct {
+	columns, rows, cellW, cellH int
+	font                        string
+}{
+	Mode80x25:  {80, 25, 9, 16, "IBM VGA"},
+	Mode80x50:  {80, 50, 9, 8, "IBM VGA50"},
+	Mode40x25:  {40, 25, 9, 16, "IBM VGA"},
+	Mode132x43: {132, 43, 8, 8, "IBM EGA43"},
+}
+
+// String returns the columns and rows of the mode, such as "80x25".
+func (m Mode) String() string {
+	if int(m) >= len(screenModes) {
+		return "Mode(" + strconv.FormatUint(uint64(m), 10) + ")"
+	}
+	return strconv.Itoa(m.Columns()) + "x" + strconv.Itoa(m.Rows())
+}
+
+// Columns returns the number of columns of the mode, or 0 for an unknown mode.
+func (m Mode) Columns() int {
+	if int(m) >= len(screenModes) {
+		return 0
+	}
+	return screenModes[m].columns
+}
+
+// Rows returns the number of rows of the mode, or 0 for an unknown mode.
+func (m Mode) Rows() int {
+	if int(m) >= len(screenModes) {
+		return 0
+	}
+	return screenModes[m].rows
+}
+
+// Font returns the SAUCE name of the default font of the mode, such as "IBM VGA50",
+// which can be registered with [RegisterFont], or an empty string for an unknown mode.
+func (m Mode) Font() string {
+	if int(m) >= len(screenModes) {
+		return ""
+	}
+	return screenModes[m].font
+}
+
+// Aspect returns the width to height ratio of each pixel when the screen of the mode fills
+// a 4:3 monitor, such as 0.74 for the 720 by 400 pixels of the 80 columns by 25 rows mode,
+// so images can be stretched to appear as they did on a CRT. An unknown mode returns 1.
+func (m Mode) Aspect() float64 {
+	const width, height = 4, 3
+	if int(m) >= len(screenModes) {
+		return 1
+	}
+	s := screenModes[m]
+	return float64(width*s.rows*s.cellH) / float64(height*s.columns*s.cellW)
+}
+
+// WithMode sets the width and the row limit of the Decoder to the columns and rows of the mode,
+// and when the default font of the mode is registered with [RegisterFont], it is used
+// by the renderers with the 9 pixel wide characters of the VGA modes.
+// An unknown mode is ignored.
+func WithMode(m Mode) Option {
+	return func(d *Decoder) {
+		if int(m) >= len(screenModes) {
+			return
+		}
+		s := screenModes[m]
+		d.columns, d.grid.width, d.maxRows = s.columns, s.columns, s.rows
+		if f, err := FontByName(s.font); err == nil {
+			d.grid.SetFont(f)
+			d.grid.SetLetterSpacing9(s.cellW == 9) //nolint:mnd
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleMode() {
+	for _, m := range []binbump.Mode{binbump.Mode80x25, binbump.Mode80x50, binbump.Mode40x25, binbump.Mode132x43} {
+		fmt.Printf("%s %d %d %q %.2f\n", m, m.Columns(), m.Rows(), m.Font(), m.Aspect())
+	}
+	// Output: 80x25 80 25 "IBM VGA" 0.74
+	// 80x50 80 50 "IBM VGA50" 0.74
+	// 40x25 40 25 "IBM VGA" 1.48
+	// 132x43 132 43 "IBM EGA43" 0.43
+}
+
+func ExampleWithMode() {
+	data := binbump.Generate(binbump.Spec{Pattern: binbump.CharTable, Width: 40, Height: 30})
+	d := binbump.NewDecoder(0, 0, binbump.StandardCGA, nil, binbump.WithMode(binbump.Mode40x25))
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	g := d.Grid()
+	fmt.Println(g.Width(), g.Height())
+	// Output: 40 25
+}
@@ -0,0 +1,101 @@
+package binbump
+
+import (
+	"io"
+)
+
+// Stats are the size statistics of a HTML fragment, that can be used to compare
+// the output of the Debug, Optimize and default modes of a [Decoder].
+type Stats struct {
+	Cells int   // Cells is the number of characters.
+	Spans int   // Spans is the number of span elements.
+	Bytes int64 // Bytes is the size of the fragment.
+}
+
+// writeOptimized writes the HTML elements of all the rows, where the span elements
+// continue across rows, but not across the containers of the ChunkRows.
+// The blank cells of spaces only need a background color, so they can join
+// any span with the same background or use a span without a foreground color.
+func (d *Decoder) writeOptimized(w *htmlWriter) {
+	var (
+		run   bool // run is true while the cells continue the text of style
+		open  bool // open is true while a span element is open
+		style string
+		bg    uint8
+	)
+	closeSpan := func() {
+		if open {
+			w.WriteString(`</span>`)
+			open = false
+		}
+		run = false
+	}
+	for y, row := range d.grid.rows {
+		var links []link
+		if d.Links {
+			links = d.grid.links(row)
+		}
+		// the span elements cannot continue across the edges of the a elements
+		endLink := func(i int) {
+			if len(links) > 0 && links[0].end == i {
+				closeSpan()
+				w.WriteString(`</a>`)
+				links = links[1:]
+			}
+		}
+		w.rowStart(y + 1)
+		for i, c := range row {
+			endLink(i)
+			if len(links) > 0 && links[0].start == i {
+				closeSpan()
+				w.anchor(links[0].href)
+			}
+			w.stats.Cells++
+			_, b := d.grid.attrColors(c)
+			blank := d.grid.Blank(c) && !d.grid.underline(c)
+			r := d.grid.rune(c)
+			if blank && !space(r) {
+				// a custom blank character is written as a space without the foreground color
+				r = ' '
+			}
+			if run && blank && bg == b {
+				w.char(r)
+				continue
+			}
+			s := w.styles[c.Attr]
+			if blank {
+				s = w.bgStyles[b]
+			}
+			if run && s == style {
+				w.char(r)
+				continue
+			}
+			closeSpan()
+			if !w.bare(s) {
+				w.span(s)
+				w.stats.Spans++
+				open = true
+			}
+			w.char(r)
+			run, style, bg = true, s, b
+		}
+		endLink(len(row))
+		if w.sep == RowDiv || w.lastOfChunk(y+1) {
+			closeSpan()
+		}
+		w.rowEnd(y + 1)
+	}
+	closeSpan()
+}
+
+// countWriter counts the bytes written to w.
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err //nolint:wrapcheck
+}
@@ -0,0 +1,48 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleDecoder_Stats() {
+	p, err := os.ReadFile("testdata/test1.bin")
+	if err != nil {
+		panic(err)
+	}
+	d := binbump.NewDecoder(80, 25, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(p)); err != nil {
+		panic(err)
+	}
+	if err := d.Write(io.Discard); err != nil {
+		panic(err)
+	}
+	fmt.Printf("default: %+v\n", d.Stats())
+	d.Optimize = true
+	if err := d.Write(io.Discard); err != nil {
+		panic(err)
+	}
+	fmt.Printf("optimize: %+v\n", d.Stats())
+	// Output: default: {Cells:2000 Spans:31 Bytes:3743}
+	// optimize: {Cells:2000 Spans:5 Bytes:2302}
+}
+
+func ExampleDecoder_Write_optimize() {
+	// the blank spaces use different foreground colors
+	data := []byte{0x20, 0x07, 0x20, 0x01, 0x41, 0x0f, 0x41, 0x0f}
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	d.Optimize = true
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	var b bytes.Buffer
+	if err := d.Write(&b); err != nil {
+		panic(err)
+	}
+	fmt.Printf("%q", b.String())
+	// Output: "<div><span style=\"background-color:#000;\">  \n</span><span style=\"color:#fff;background-color:#000;\">AA\n</span></div>"
+}
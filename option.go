@@ -0,0 +1,139 @@
+package binbump
+
+import (
+	"log/slog"
+
+	"github.com/bengarrett/binbump/sauce"
+)
+
+// Option configures a [Decoder] created by [NewDecoder].
+type Option func(*Decoder)
+
+// WithProgress sets a function that is called after each row is decoded, with the
+// number of rows decoded and bytes read so far, so that frontends can show the
+// progress of very large or slow, network-backed readers.
+func WithProgress(fn func(rowsDone, bytesRead int)) Option {
+	return func(d *Decoder) {
+		d.progress = fn
+	}
+}
+
+// WithLogger sets the structured logger that receives the diagnostics of the Decoder,
+// such as scanner errors of a failing Reader. By default the diagnostics are discarded.
+// A nil logger is ignored.
+func WithLogger(l *slog.Logger) Option {
+	return func(d *Decoder) {
+		if l != nil {
+			d.logger = l
+		}
+	}
+}
+
+// WithIgnoreScanErrors restores the behavior of earlier releases where the errors
+// returned by a Reader are logged, but not returned by [Decoder.Read].
+// This renders whatever was read from truncated or failing Readers,
+// but a [LimitError] of [WithLimits] is still returned.
+// It is the same as WithErrorPolicy(ReplaceWithDefault).
+func WithIgnoreScanErrors() Option {
+	return WithErrorPolicy(ReplaceWithDefault)
+}
+
+// ErrorPolicy is the strategy of the [Decoder] for handling the errors of the Reader.
+//
+// Every pair of bytes decodes to a valid cell, so the policy only applies to failing
+// or truncated Readers, and a [LimitError] of [WithLimits] is always returned.
+type ErrorPolicy uint
+
+const (
+	// Strict stops decoding and returns a [DecodeError] at the first error of the Reader.
+	Strict ErrorPolicy = iota
+	// ReplaceWithDefault logs, but does not return, the errors of the Reader,
+	// leaving the grid with the rows decoded before the error.
+	ReplaceWithDefault
+)
+
+// WithErrorPolicy sets the strategy for handling the errors of the Reader, so that
+// truncated dumps can still be rendered instead of aborting the whole conversion.
+// The default is [Strict].
+func WithErrorPolicy(p ErrorPolicy) Option {
+	return func(d *Decoder) {
+		d.policy = p
+	}
+}
+
+// WithTrimMetadata reads all the data before decoding and truncates it with [TrimMetadata],
+// so that files with SAUCE metadata can be rendered without guessing a maximum row count.
+// The SAUCE record is kept for the metadata of [Decoder.WriteDocument].
+func WithTrimMetadata() Option {
+	return func(d *Decoder) {
+		d.trim = true
+	}
+}
+
+// WithFont sets the bitmap font of the grid using [Grid.SetFont], which [Decoder.WriteDocument]
+// embeds as a web font, so the document uses the glyph shapes intended by the artist.
+// A nil font is ignored.
+func WithFont(f *Font) Option {
+	return func(d *Decoder) {
+		if f != nil {
+			d.grid.SetFont(f)
+		}
+	}
+}
+
+// WithStripBlink masks bit 7 of every attribute before it is decoded, for captures that
+// set the blink bit with garbage, so they render with normal backgrounds and without
+// any blink or iCE color interpretation by the renderers.
+func WithStripBlink() Option {
+	return func(d *Decoder) {
+		d.stripBlink = true
+	}
+}
+
+// WithReverseVideo swaps the foreground and background colors of every cell as it is decoded,
+// for screens that were captured with inverted attributes. Use [Grid.Reverse] to swap
+// the colors of a region.
+func WithReverseVideo() Option {
+	return func(d *Decoder) {
+		d.reverse = true
+	}
+}
+
+// Metadata are the ways [Decoder.WriteDocument] surfaces the SAUCE title, author, group,
+// date and comments of the file, which can be combined.
+type Metadata uint
+
+const (
+	// MetaTags writes <meta> elements using the Dublin Core names, such as dcterms.title.
+	MetaTags Metadata = 1 << iota
+	// Caption writes a <figcaption> block below the screen.
+	Caption
+	// JSONLD writes a JSON-LD script of a schema.org VisualArtwork.
+	JSONLD
+)
+
+// WithMetadata sets the ways [Decoder.WriteDocument] surfaces the SAUCE metadata,
+// so archive pages get the metadata for free. The SAUCE record is read by [DecodeFile]
+// and [WithTrimMetadata], or it can be set with [WithRecord].
+func WithMetadata(m Metadata) Option {
+	return func(d *Decoder) {
+		d.metadata = m
+	}
+}
+
+// WithResponsive sets [Decoder.WriteDocument] to scale the font size of the screen to
+// fit the width of the browser window, so wide screens such as those of 160 columns
+// are viewable on phones. The scale uses CSS container query units without JavaScript,
+// and the screen is never enlarged beyond the size of its font.
+func WithResponsive() Option {
+	return func(d *Decoder) {
+		d.responsive = true
+	}
+}
+
+// WithRecord sets the SAUCE record used by [WithMetadata], for data without the record.
+func WithRecord(r sauce.Record) Option {
+	return func(d *Decoder) {
+		d.record = &r
+	}
+}
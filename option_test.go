@@ -0,0 +1,94 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"testing/iotest"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleWithProgress() {
+	data := bytes.Repeat([]byte{0x41, 0x07}, 80*3)
+	progress := binbump.WithProgress(func(rowsDone, bytesRead int) {
+		fmt.Printf("row %d, %d bytes\n", rowsDone, bytesRead)
+	})
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil, progress)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	// Output: row 1, 160 bytes
+	// row 2, 320 bytes
+	// row 3, 480 bytes
+}
+
+func ExampleWithLogger() {
+	data := bytes.Repeat([]byte{0x41, 0x07}, 80*3)
+	h := slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil, binbump.WithLogger(slog.New(h)))
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	// Output: level=DEBUG msg="binbump decoder read" rows=3 bytes=480
+}
+
+func ExampleWithIgnoreScanErrors() {
+	data := bytes.NewReader([]byte{0x41, 0x07, 0x42, 0x07})
+	r := io.MultiReader(data, iotest.ErrReader(io.ErrUnexpectedEOF))
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil)
+	err := d.Read(r)
+	fmt.Println(err)
+
+	data.Reset([]byte{0x41, 0x07, 0x42, 0x07})
+	r = io.MultiReader(data, iotest.ErrReader(io.ErrUnexpectedEOF))
+	d = binbump.NewDecoder(80, 0, binbump.StandardCGA, nil, binbump.WithIgnoreScanErrors())
+	err = d.Read(r)
+	fmt.Println(err, d.Grid().Transcript())
+	// Output: offset 4 (row 0, col 2): decoder scan: unexpected EOF
+	// <nil> AB
+}
+
+func ExampleWithErrorPolicy() {
+	data := bytes.NewReader([]byte{0x41, 0x07, 0x42, 0x07})
+	r := io.MultiReader(data, iotest.ErrReader(io.ErrUnexpectedEOF))
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil,
+		binbump.WithErrorPolicy(binbump.ReplaceWithDefault))
+	err := d.Read(r)
+	fmt.Println(err, d.Grid().Transcript())
+	// Output: <nil> AB
+}
+
+func ExampleWithStripBlink() {
+	data := []byte{0x41, 0x9f}
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil, binbump.WithStripBlink())
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	c, _ := d.Grid().At(0, 0)
+	fmt.Printf("%#02x %v\n", c.Attr, c.Blink())
+	// Output: 0x1f false
+}
+
+func ExampleWithReverseVideo() {
+	data := []byte{0x41, 0x07}
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil, binbump.WithReverseVideo())
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: <div><span style="color:#000;background-color:#aaa;">A</span>
+	// </div>
+}
@@ -0,0 +1,44 @@
+package binbump
+
+// TransparentNUL reports whether the cell is a NUL character,
+// for use as the transparent function of [Grid.Overlay].
+func TransparentNUL(c Cell) bool {
+	return c.Char == 0
+}
+
+// TransparentBlank reports whether the cell is [Grid.Blank] on a black background,
+// for use as the transparent function of [Grid.Overlay] with the grid that is layered,
+// such as screen.Overlay(panel, x, y, panel.TransparentBlank).
+func (g *Grid) TransparentBlank(c Cell) bool {
+	return g.emptyCell(c)
+}
+
+// Overlay layers the other grid over this grid, with the top-left of the other grid
+// placed at the zero-based column and row, so screens can be assembled from reusable panels.
+// The cells of the other grid where transparent returns true are skipped, leaving the
+// cells beneath visible. A nil transparent function copies every cell.
+//
+// The overlay is clipped to this grid, and if the grids use different charsets,
+// the characters are converted to the charset of this grid.
+func (g *Grid) Overlay(other *Grid, x, y int, transparent func(Cell) bool) {
+	if other == nil {
+		return
+	}
+	const unknown = '?'
+	convert := other.charset != g.charset
+	for row, cells := range other.rows {
+		for col, c := range cells {
+			if transparent != nil && transparent(c) {
+				continue
+			}
+			if convert {
+				b, ok := g.charset.EncodeRune(other.rune(c))
+				if !ok {
+					b = unknown
+				}
+				c.Char = b
+			}
+			g.Set(x+col, y+row, c)
+		}
+	}
+}
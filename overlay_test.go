@@ -0,0 +1,26 @@
+package binbump_test
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_Overlay() {
+	screen := binbump.NewGrid(20, 3, binbump.StandardCGA, nil)
+	screen.Fill(image.Rect(0, 0, 20, 3), binbump.Cell{Char: 0xb0, Attr: 0x01})
+	panel := binbump.NewGrid(8, 1, binbump.StandardCGA, nil)
+	panel.Print(0, 0, " MENU", 15, 4)
+	// the space cells of the panel are on a black background, so they are transparent
+	screen.Overlay(panel, 6, 1, panel.TransparentBlank)
+	c, _ := screen.At(6, 1)
+	fmt.Printf("%#x %#x\n", c.Char, c.Attr)
+	c, _ = screen.At(7, 1)
+	fmt.Printf("%c %#x\n", c.Char, c.Attr)
+	c, _ = screen.At(13, 1)
+	fmt.Printf("%#x %#x\n", c.Char, c.Attr)
+	// Output: 0x20 0x4f
+	// M 0x4f
+	// 0xb0 0x1
+}
@@ -0,0 +1,86 @@
+package binbump
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// palettes is the registry of the palettes addressable by name.
+//
+//nolint:gochecknoglobals
+var palettes = struct {
+	sync.RWMutex
+	names  map[string]Palette
+	custom map[Palette]Colors
+}{
+	names: map[string]Palette{
+		"cga":         StandardCGA,
+		"revised-cga": RevisedCGA,
+		"tandy":       Tandy,
+		"composite":   Composite,
+		"mda":         MDA,
+	},
+	custom: map[Palette]Colors{},
+}
+
+// PaletteByName returns the palette of the name, so that command-line tools, HTTP query
+// parameters and configuration files can select palettes with strings. The names of
+// the built-in palettes are "cga", "revised-cga", "tandy", "composite" and "mda",
+// and the name is case-insensitive. An unknown name returns [ErrPalette].
+func PaletteByName(name string) (Palette, error) {
+	palettes.RLock()
+	defer palettes.RUnlock()
+	if pal, ok := palettes.names[strings.ToLower(strings.TrimSpace(name))]; ok {
+		return pal, nil
+	}
+	return StandardCGA, fmt.Errorf("%w: %q", ErrPalette, name)
+}
+
+// RegisterPalette adds a custom colorset to the registry with the name, and returns the
+// new palette that can be used with [NewDecoder], [NewGrid] and [PaletteByName].
+// Registering an existing name replaces the colorset of a custom palette,
+// but the built-in palettes cannot be replaced and return [ErrPalette].
+// A colorset with a malformed color returns [ErrColor].
+func RegisterPalette(name string, c Colors) (Palette, error) {
+	key := strings.ToLower(strings.TrimSpace(name))
+	if key == "" {
+		return StandardCGA, fmt.Errorf("%w: %q", ErrPalette, name)
+	}
+	if err := c.Validate(); err != nil {
+		return StandardCGA, err
+	}
+	palettes.Lock()
+	defer palettes.Unlock()
+	pal, ok := palettes.names[key]
+	if ok && pal <= MDA {
+		return StandardCGA, fmt.Errorf("%w, the built-in palette cannot be replaced: %q", ErrPalette, name)
+	}
+	if !ok {
+		pal = MDA + 1 + Palette(len(palettes.custom)) //nolint:gosec
+		palettes.names[key] = pal
+	}
+	palettes.custom[pal] = c
+	return pal, nil
+}
+
+// Palettes returns the sorted names of the palettes available to [PaletteByName].
+func Palettes() []string {
+	palettes.RLock()
+	defer palettes.RUnlock()
+	names := make([]string, 0, len(palettes.names))
+	for name := range palettes.names {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// customColors returns the colorset of a registered custom palette.
+func customColors(pal Palette) (Colors, bool) {
+	palettes.RLock()
+	defer palettes.RUnlock()
+	c, ok := palettes.custom[pal]
+	return c, ok
+}
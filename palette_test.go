@@ -0,0 +1,39 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExamplePaletteByName() {
+	pal, err := binbump.PaletteByName("revised-cga")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(pal == binbump.RevisedCGA)
+	_, err = binbump.PaletteByName("vga")
+	fmt.Println(err)
+	// Output: true
+	// palette name is unknown: "vga"
+}
+
+func ExampleRegisterPalette() {
+	amber := binbump.CGA()
+	amber[7] = "fb0"
+	pal, err := binbump.RegisterPalette("amber", amber)
+	if err != nil {
+		panic(err)
+	}
+	named, _ := binbump.PaletteByName("Amber")
+	data := []byte{0x41, 0x07}
+	buf, _ := binbump.Buffer(bytes.NewReader(data), 80, 0, named, nil)
+	fmt.Println(pal == named)
+	fmt.Println(buf)
+	fmt.Println(binbump.Palettes())
+	// Output: true
+	// <div><span style="color:#fb0;background-color:#000;">A</span>
+	// </div>
+	// [amber cga composite mda revised-cga tandy]
+}
@@ -0,0 +1,47 @@
+package binbump
+
+import (
+	"bufio"
+	"bytes"
+	"sync"
+)
+
+// parallelChunk is the number of rows rendered by a worker at a time.
+const parallelChunk = 64
+
+// writeParallel writes the HTML elements of all the rows using a pool of workers.
+// Each worker renders chunks of rows to their own buffer, which are then written in order.
+func (d *Decoder) writeParallel(w *htmlWriter) {
+	rows := d.grid.rows
+	chunks := (len(rows) + parallelChunk - 1) / parallelChunk
+	bufs := make([]bytes.Buffer, chunks)
+	stats := make([]Stats, chunks)
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(d.Workers, chunks) {
+		wg.Go(func() {
+			for j := range jobs {
+				cw := *w
+				cw.Writer = bufio.NewWriter(&bufs[j])
+				cw.num = nil
+				cw.stats = Stats{}
+				first := j * parallelChunk
+				for i, row := range rows[first:min(first+parallelChunk, len(rows))] {
+					d.writeRow(&cw, first+i+1, row)
+				}
+				_ = cw.Flush() // a bytes.Buffer never returns an error
+				stats[j] = cw.stats
+			}
+		})
+	}
+	for j := range chunks {
+		jobs <- j
+	}
+	close(jobs)
+	wg.Wait()
+	for j := range bufs {
+		w.Write(bufs[j].Bytes())
+		w.stats.Cells += stats[j].Cells
+		w.stats.Spans += stats[j].Spans
+	}
+}
@@ -0,0 +1,32 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleDecoder_Write_workers() {
+	data := []byte{}
+	for i := range 160 * 500 {
+		data = append(data, byte('A'+i%26), byte(i/3))
+	}
+	d := binbump.NewDecoder(160, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	var seq, par bytes.Buffer
+	if err := d.Write(&seq); err != nil {
+		panic(err)
+	}
+	d.Workers = runtime.NumCPU() + 1
+	if err := d.Write(&par); err != nil {
+		panic(err)
+	}
+	fmt.Println(bytes.Equal(seq.Bytes(), par.Bytes()))
+	fmt.Printf("%+v", d.Stats())
+	// Output: true
+	// {Cells:80000 Spans:27000 Bytes:1565511}
+}
@@ -0,0 +1,258 @@
+package binbump
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// tagAttr matches the attributes of a HTML tag.
+//
+//nolint:gochecknoglobals
+var tagAttr = regexp.MustCompile(`([a-zA-Z_:][-a-zA-Z0-9_:.]*)(?:\s*=\s*"([^"]*)")?`)
+
+// classRule matches the class rules of a <style> element written by the ClassPrefix mode.
+//
+//nolint:gochecknoglobals
+var classRule = regexp.MustCompile(`\.([-_a-zA-Z0-9]+)\{([^}]*)\}`)
+
+// voidTags are the HTML elements without a closing tag.
+//
+//nolint:gochecknoglobals
+var voidTags = map[string]bool{"br": true, "hr": true, "img": true, "input": true, "meta": true, "wbr": true}
+
+// parseState is an open element of the HTML parsed by [ParseHTML],
+// with the colors of its text.
+type parseState struct {
+	tag    string
+	fg, bg uint8
+}
+
+// htmlParser reads the HTML of [Decoder.Write] into a grid.
+type htmlParser struct {
+	g       *Grid
+	stack   []parseState
+	classes map[string]string // classes are the styles of the class names
+	line    []Cell
+}
+
+// ParseHTML reads the HTML fragment written by [Decoder.Write] back into a grid, for
+// round-trip editing and the recovery of the source data from published pages.
+// The width, palette and charset arguments are the same as those of [NewGrid].
+//
+// The colors of the span elements are read from their style attributes, or the classes
+// of the ClassPrefix mode, and matched to the nearest foreground and background colors of
+// the palette, where elements without colors use the colors of their parent element,
+// or gray on black. The characters are encoded to the charset, where any that are not in
+// the charset are replaced with a question mark.
+//
+// The rows are separated by newlines, <br> elements and the closing tags of nested
+// div elements, while rows longer than the width, such as those of the Minify mode,
+// are wrapped. If width <= 0, the width is the longest row. The Indent mode is not supported,
+// as its newlines cannot be told apart from the rows.
+//
+// The source data cannot be fully recovered, as the HTML does not include everything:
+//   - bit 7 of the attribute, the blink bit, is not rendered, so it is always cleared
+//   - the foreground colors of the blank cells of the Optimize mode are not rendered,
+//     so they are gray
+//
+// The control characters are recovered, as the line feed and carriage return characters
+// are written as character references by [Decoder.Write].
+func ParseHTML(r io.Reader, width int, pal Palette, charset *charmap.Charmap) (*Grid, error) {
+	if r == nil {
+		return nil, ErrReader
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("parse html: %w", err)
+	}
+	if charset == nil {
+		charset = charmap.CodePage437
+	}
+	p := htmlParser{
+		g:       &Grid{charset: charset, width: max(width, 0)},
+		classes: map[string]string{},
+	}
+	p.g.setPalette(pal)
+	const defaultFG, defaultBG = 7, 0
+	p.stack = []parseState{{fg: defaultFG, bg: defaultBG}}
+	s := string(data)
+	for s != "" {
+		i := strings.IndexByte(s, '<')
+		if i < 0 {
+			i = len(s)
+		}
+		p.text(s[:i])
+		s = s[i:]
+		if s == "" {
+			break
+		}
+		end := strings.IndexByte(s, '>')
+		if end < 0 {
+			return nil, fmt.Errorf("parse html %q: %w", s[:min(len(s), 16)], ErrHTML) //nolint:mnd
+		}
+		tag := s[1:end]
+		s = s[end+1:]
+		if raw := p.tag(tag); raw != "" {
+			// skip the content of the elements that are not part of the fragment
+			i := strings.Index(strings.ToLower(s), "</"+raw)
+			if i < 0 {
+				i = len(s)
+			}
+			if raw == "style" {
+				p.style(s[:i])
+			}
+			s = s[i:]
+		}
+	}
+	if len(p.line) > 0 {
+		p.endRow()
+	}
+	// the newlines around the fragment, such as those of a document, are not rows
+	for len(p.g.rows) > 0 && len(p.g.rows[0]) == 0 {
+		p.g.rows = p.g.rows[1:]
+	}
+	for len(p.g.rows) > 0 && len(p.g.rows[len(p.g.rows)-1]) == 0 {
+		p.g.rows = p.g.rows[:len(p.g.rows)-1]
+	}
+	if p.g.width == 0 {
+		for _, row := range p.g.rows {
+			p.g.width = max(p.g.width, len(row))
+		}
+	}
+	return p.g, nil
+}
+
+// tag handles the content of a tag, and returns the name of a head, title, style or
+// script element, whose content is not text.
+func (p *htmlParser) tag(tag string) string {
+	if strings.HasPrefix(tag, "!") || strings.HasPrefix(tag, "?") {
+		return ""
+	}
+	if name, ok := strings.CutPrefix(tag, "/"); ok {
+		p.closeTag(strings.ToLower(strings.TrimSpace(name)))
+		return ""
+	}
+	name, attrs, _ := strings.Cut(strings.TrimSuffix(tag, "/"), " ")
+	name = strings.ToLower(name)
+	switch {
+	case name == "br":
+		p.endRow()
+		return ""
+	case name == "style", name == "script", name == "title", name == "head":
+		return name
+	case voidTags[name]:
+		return ""
+	}
+	state := p.stack[len(p.stack)-1]
+	state.tag = name
+	for _, m := range tagAttr.FindAllStringSubmatch(attrs, -1) {
+		switch strings.ToLower(m[1]) {
+		case "style":
+			p.declarations(&state, html.UnescapeString(m[2]))
+		case "class":
+			for class := range strings.FieldsSeq(m[2]) {
+				if style, ok := p.classes[class]; ok {
+					p.declarations(&state, style)
+				}
+			}
+		}
+	}
+	p.stack = append(p.stack, state)
+	return ""
+}
+
+// closeTag closes the most recent open element of the name, where the closing tag of
+// a nested div element ends the row.
+func (p *htmlParser) closeTag(name string) {
+	for i := len(p.stack) - 1; i > 0; i-- {
+		if p.stack[i].tag != name {
+			continue
+		}
+		p.stack = p.stack[:i]
+		nested := false
+		for _, s := range p.stack[1:] {
+			nested = nested || s.tag == "div"
+		}
+		if name == "div" && nested && len(p.line) > 0 {
+			p.endRow()
+		}
+		return
+	}
+}
+
+// declarations sets the colors of the state to the color and background-color declarations
+// of the style.
+func (p *htmlParser) declarations(state *parseState, style string) {
+	const backgrounds = 8
+	for decl := range strings.SplitSeq(style, ";") {
+		prop, value, ok := strings.Cut(decl, ":")
+		if !ok {
+			continue
+		}
+		c, err := ParseColor(strings.TrimSpace(value))
+		if err != nil {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(prop)) {
+		case "color":
+			state.fg = p.nearest(c, len(p.g.colors))
+		case "background-color", "background":
+			state.bg = p.nearest(c, backgrounds)
+		}
+	}
+}
+
+// nearest returns the code of the first n colors of the grid nearest to the color.
+func (p *htmlParser) nearest(c Color, n int) uint8 {
+	r, g, b := c.RGB()
+	best, dist := 0, -1
+	for i, col := range p.g.colors[:n] {
+		cr, cg, cb := col.RGB()
+		dr, dg, db := int(r)-int(cr), int(g)-int(cg), int(b)-int(cb)
+		if d := dr*dr + dg*dg + db*db; dist < 0 || d < dist {
+			best, dist = i, d
+		}
+	}
+	return uint8(best) //nolint:gosec
+}
+
+// style reads the class rules of the content of a style element.
+func (p *htmlParser) style(css string) {
+	for _, m := range classRule.FindAllStringSubmatch(css, -1) {
+		p.classes[m[1]] = m[2]
+	}
+}
+
+// text appends the characters of the raw text to the row, using the colors of the open
+// element, where the newlines end the row and the character references are unescaped.
+func (p *htmlParser) text(raw string) {
+	const unknown = '?'
+	state := p.stack[len(p.stack)-1]
+	attr := attribute(state.fg, state.bg)
+	for i, line := range strings.Split(raw, "\n") {
+		if i > 0 {
+			p.endRow()
+		}
+		for _, r := range html.UnescapeString(line) {
+			if p.g.width > 0 && len(p.line) == p.g.width {
+				p.endRow()
+			}
+			b, ok := p.g.charset.EncodeRune(r)
+			if !ok {
+				b = unknown
+			}
+			p.line = append(p.line, Cell{Char: b, Attr: attr})
+		}
+	}
+}
+
+// endRow appends the row to the grid.
+func (p *htmlParser) endRow() {
+	p.g.rows = append(p.g.rows, p.line)
+	p.line = nil
+}
@@ -0,0 +1,65 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleParseHTML() {
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.Read(strings.NewReader("H\x07i\x1e<\x4f \x07")); err != nil {
+		panic(err)
+	}
+	g, err := binbump.ParseHTML(strings.NewReader(d.String()), 0, binbump.StandardCGA, nil)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(g.Width(), g.Height())
+	for p, c := range g.Cells() {
+		fmt.Printf("%d,%d %q %02x\n", p.X, p.Y, c.Char, c.Attr)
+	}
+	// Output: 2 2
+	// 0,0 'H' 07
+	// 1,0 'i' 1e
+	// 0,1 '<' 4f
+	// 1,1 ' ' 07
+}
+
+func ExampleParseHTML_roundTrip() {
+	// every character code, including the control codes, with the same attribute
+	data := make([]byte, 0, 512)
+	for i := range 256 {
+		data = append(data, byte(i), byte(i))
+	}
+	d := binbump.NewDecoder(16, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	g, err := binbump.ParseHTML(strings.NewReader(d.String()), 16, binbump.StandardCGA, nil)
+	if err != nil {
+		panic(err)
+	}
+	chars, attrs, blinks := 0, 0, 0
+	for p, c := range g.Cells() {
+		i := byte(p.Y*16 + p.X)
+		switch {
+		case c.Char != i:
+			chars++
+		case c.Attr == i:
+			attrs++
+		case c.Attr == i&^0x80:
+			blinks++
+		}
+	}
+	fmt.Println(g.Width(), g.Height())
+	fmt.Println(chars, "characters differ")
+	fmt.Println(attrs, "attributes match")
+	fmt.Println(blinks, "attributes without the blink bit")
+	// Output: 16 16
+	// 0 characters differ
+	// 128 attributes match
+	// 128 attributes without the blink bit
+}
@@ -0,0 +1,92 @@
+package binbump
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Change is a cell that differs between two grids and its zero-based position.
+type Change struct {
+	Point
+	Cell Cell
+}
+
+// Delta is the list of cells that changed between two frames of a screen,
+// ordered from left to right and top to bottom.
+type Delta []Change
+
+// Patch returns the cells of grid b that differ from grid a, so that a recording
+// of screen updates can be stored as a first frame followed by a delta for each frame.
+// The cells of b that are outside of a are always included, while cells only found in a
+// are ignored. If a is nil, every cell of b is returned.
+func Patch(a, b *Grid) Delta {
+	if b == nil {
+		return nil
+	}
+	delta := Delta{}
+	for y, row := range b.rows {
+		for x, c := range row {
+			if a != nil {
+				if prev, ok := a.At(x, y); ok && prev == c {
+					continue
+				}
+			}
+			delta = append(delta, Change{Point: Point{X: x, Y: y}, Cell: c})
+		}
+	}
+	return delta
+}
+
+// Apply replaces the cells of the grid with the changes of the delta,
+// to play back the next frame of a recording.
+// Changes outside the width or height of the grid are skipped.
+// The return int is the number of cells replaced.
+func (g *Grid) Apply(delta Delta) int {
+	n := 0
+	for _, c := range delta {
+		if g.Set(c.X, c.Y, c.Cell) {
+			n++
+		}
+	}
+	return n
+}
+
+// MarshalBinary encodes the delta as a compact sequence of changes, where each change is
+// the column and row as unsigned varints, followed by the character and attribute bytes.
+func (d Delta) MarshalBinary() ([]byte, error) {
+	const pair = 2
+	b := make([]byte, 0, len(d)*(pair+pair))
+	for _, c := range d {
+		b = binary.AppendUvarint(b, uint64(c.X)) //nolint:gosec
+		b = binary.AppendUvarint(b, uint64(c.Y)) //nolint:gosec
+		b = append(b, c.Cell.Char, c.Cell.Attr)
+	}
+	return b, nil
+}
+
+// UnmarshalBinary decodes the delta from the data created by [Delta.MarshalBinary].
+func (d *Delta) UnmarshalBinary(data []byte) error {
+	delta := Delta{}
+	for i := 0; i < len(data); {
+		var pos [2]int
+		for j := range pos {
+			v, n := binary.Uvarint(data[i:])
+			if n <= 0 {
+				return fmt.Errorf("delta change %d: %w", len(delta), ErrDelta)
+			}
+			pos[j] = int(v) //nolint:gosec
+			i += n
+		}
+		const pair = 2
+		if i+pair > len(data) {
+			return fmt.Errorf("delta change %d: %w", len(delta), ErrDelta)
+		}
+		delta = append(delta, Change{
+			Point: Point{X: pos[0], Y: pos[1]},
+			Cell:  Cell{Char: data[i], Attr: data[i+1]},
+		})
+		i += pair
+	}
+	*d = delta
+	return nil
+}
@@ -0,0 +1,42 @@
+package binbump_test
+
+import (
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExamplePatch() {
+	frame1 := binbump.NewGrid(10, 2, binbump.StandardCGA, nil)
+	frame1.Print(0, 0, "Loading", 7, 0)
+	frame2 := binbump.NewGrid(10, 2, binbump.StandardCGA, nil)
+	frame2.Print(0, 0, "Loading.", 7, 0)
+	frame2.Print(0, 1, "OK", 10, 0)
+
+	delta := binbump.Patch(frame1, frame2)
+	for _, c := range delta {
+		fmt.Printf("%d,%d %c %#x\n", c.X, c.Y, c.Cell.Char, c.Cell.Attr)
+	}
+	fmt.Println(frame1.Apply(delta), "cells replaced")
+	fmt.Println(len(binbump.Patch(frame1, frame2)), "changes remaining")
+	// Output: 7,0 . 0x7
+	// 0,1 O 0xa
+	// 1,1 K 0xa
+	// 3 cells replaced
+	// 0 changes remaining
+}
+
+func ExampleDelta_MarshalBinary() {
+	delta := binbump.Delta{
+		{Point: binbump.Point{X: 5, Y: 200}, Cell: binbump.Cell{Char: 'A', Attr: 0x1f}},
+	}
+	b, _ := delta.MarshalBinary()
+	fmt.Printf("% x\n", b)
+	var d binbump.Delta
+	if err := d.UnmarshalBinary(b); err != nil {
+		panic(err)
+	}
+	fmt.Println(d[0].X, d[0].Y, string(rune(d[0].Cell.Char)))
+	// Output: 05 c8 01 41 1f
+	// 5 200 A
+}
@@ -0,0 +1,307 @@
+package binbump
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"io"
+	"strconv"
+)
+
+const (
+	pdfCellH    = 12 // cell height in points
+	pdfMargin   = 18 // page margin in points
+	pdfPageRows = 50 // maximum number of rows on a page
+)
+
+// WritePDF writes to w the grid as a print-ready, self-contained PDF document.
+// Each page holds up to 50 rows, and every cell is drawn as vector text on a colored rectangle.
+//
+// The text uses a Type 3 font embedded in the document, with each pixel of the glyphs drawn
+// as a square, so every character of the charset keeps its shape in any PDF reader.
+// The glyphs are those of the font set by [Grid.SetFont], otherwise the built-in font of
+// [Grid.Image], and only the characters used by the grid are embedded.
+func (g *Grid) WritePDF(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	pages := [][][]Cell{}
+	for i := 0; i < len(g.rows); i += pdfPageRows {
+		pages = append(pages, g.rows[i:min(i+pdfPageRows, len(g.rows))])
+	}
+	if len(pages) == 0 {
+		pages = append(pages, nil)
+	}
+	f := g.pdfFont()
+	cellW := float64(pdfCellH*f.Width) / float64(f.Height)
+	width := pdfNum(float64(g.width)*cellW + 2*pdfMargin)
+	codes := g.usedCodes()
+	// the catalog, page tree and font are objects 1 to 3,
+	// followed by a page and content stream object pair for each page,
+	// then the glyph procedures of the font and a stream object for each glyph
+	const firstPage = 4
+	charProcs := firstPage + 2*len(pages)
+	var doc bytes.Buffer
+	offsets := []int{}
+	object := func(body string) {
+		offsets = append(offsets, doc.Len())
+		fmt.Fprintf(&doc, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+	doc.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	kids := ""
+	for i := range pages {
+		kids += strconv.Itoa(firstPage+2*i) + " 0 R "
+	}
+	object(fmt.Sprintf("<< /Type /Pages /Kids [ %s] /Count %d >>", kids, len(pages)))
+	object(pdfType3(f, codes, charProcs))
+	for i, rows := range pages {
+		height := len(rows)*pdfCellH + 2*pdfMargin
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %d] "+
+			"/Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			width, height, firstPage+2*i+1))
+		stream, err := pdfStream(g.pdfContent(rows, height, cellW, f))
+		if err != nil {
+			return err
+		}
+		object(stream)
+	}
+	procs := ""
+	for i, code := range codes {
+		procs += fmt.Sprintf("/g%02x %d 0 R ", code, charProcs+1+i)
+	}
+	object("<< " + procs + ">>")
+	for _, code := range codes {
+		stream, err := pdfStream(pdfGlyph(f, code))
+		if err != nil {
+			return err
+		}
+		object(stream)
+	}
+	xref := doc.Len()
+	fmt.Fprintf(&doc, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&doc, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&doc, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(offsets)+1, xref)
+	if _, err := doc.WriteTo(w); err != nil {
+		return fmt.Errorf("write pdf: %w", err)
+	}
+	return nil
+}
+
+// pdfFont returns the font of the grid, or the built-in font of [Grid.Image]
+// drawn as the 256 glyphs of the charset.
+func (g *Grid) pdfFont() *Font {
+	if f := g.renderFont(); f != nil {
+		return f
+	}
+	const white = 0x0f
+	a := &Grid{charset: g.charset, colors: CGA()}
+	img := image.NewRGBA(image.Rect(0, 0, cellW, cellH))
+	fg := rgba(White)
+	f := &Font{Width: cellW, Height: cellH}
+	for code := range f.Glyphs {
+		a.drawCell(img, image.Point{}, Cell{Char: byte(code), Attr: white}) //nolint:gosec
+		glyph := make([]byte, cellH)
+		for y := range cellH {
+			for x := range cellW {
+				if img.RGBAAt(x, y) == fg {
+					glyph[y] |= 0x80 >> x
+				}
+			}
+		}
+		f.Glyphs[code] = glyph
+	}
+	return f
+}
+
+// usedCodes returns the sorted character codes of the cells in the grid.
+func (g *Grid) usedCodes() []byte {
+	var used [256]bool
+	for _, row := range g.rows {
+		for _, c := range row {
+			used[c.Char] = true
+		}
+	}
+	codes := []byte{}
+	for code, ok := range used {
+		if ok {
+			codes = append(codes, byte(code)) //nolint:gosec
+		}
+	}
+	return codes
+}
+
+// pdfType3 returns the dictionary of a Type 3 font of the glyphs of the codes,
+// where a glyph is a pixel unit and the em square is the height of the glyphs.
+// The glyph procedures are in the charProcs object, followed by an object for each glyph.
+func pdfType3(f *Font, codes []byte, charProcs int) string {
+	first, last := 0, 0
+	if len(codes) > 0 {
+		first, last = int(codes[0]), int(codes[len(codes)-1])
+	}
+	diffs, widths := "", ""
+	for _, code := range codes {
+		diffs += fmt.Sprintf("%d /g%02x ", code, code)
+	}
+	for range last - first + 1 {
+		widths += strconv.Itoa(f.Width) + " "
+	}
+	scale := strconv.FormatFloat(1/float64(f.Height), 'f', -1, 64)
+	return fmt.Sprintf("<< /Type /Font /Subtype /Type3 /FontBBox [0 0 %d %d] "+
+		"/FontMatrix [%s 0 0 %s 0 0] /CharProcs %d 0 R "+
+		"/Encoding << /Type /Encoding /Differences [ %s] >> "+
+		"/FirstChar %d /LastChar %d /Widths [ %s] /Resources << >> >>",
+		f.Width, f.Height, scale, scale, charProcs, diffs, first, last, widths)
+}
+
+// pdfGlyph returns the glyph procedure of the code, which fills a rectangle for each run
+// of set pixels in a row, with the origin at the bottom-left of the glyph.
+// The glyph is uncolored so it is drawn in the fill color of the text.
+func pdfGlyph(f *Font, code byte) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%d 0 0 0 %d %d d1\n", f.Width, f.Width, f.Height)
+	for y := range f.Height {
+		for x := 0; x < f.Width; x++ {
+			if !f.pixel(code, x, y) {
+				continue
+			}
+			n := 1
+			for x+n < f.Width && f.pixel(code, x+n, y) {
+				n++
+			}
+			fmt.Fprintf(&b, "%d %d %d 1 re\n", x, f.Height-1-y, n)
+			x += n
+		}
+	}
+	b.WriteString("f\n")
+	return b.Bytes()
+}
+
+// pdfStream returns the stream object of the data compressed by zlib.
+func pdfStream(data []byte) (string, error) {
+	var stream bytes.Buffer
+	zw := zlib.NewWriter(&stream)
+	if _, err := zw.Write(data); err != nil {
+		return "", fmt.Errorf("write pdf compress: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return "", fmt.Errorf("write pdf compress: %w", err)
+	}
+	return fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream",
+		stream.Len(), stream.String()), nil
+}
+
+// pdfContent returns the page content stream operators that draw the rows of cells,
+// with each cell cellW points wide, using the glyphs of the font.
+func (g *Grid) pdfContent(rows [][]Cell, height int, cellW float64, f *Font) []byte {
+	var b bytes.Buffer
+	for y, row := range rows {
+		bottom := float64(height - pdfMargin - (y+1)*pdfCellH)
+		// backgrounds are drawn first as runs of the same color
+		for x := 0; x < len(row); {
+			_, bg := g.attrColors(row[x])
+			n := 1
+			for ; x+n < len(row); n++ {
+				if _, next := g.attrColors(row[x+n]); next != bg {
+					break
+				}
+			}
+			pdfColor(&b, g.colors[bg])
+			pdfRect(&b, pdfMargin+float64(x)*cellW, bottom, float64(n)*cellW, pdfCellH)
+			x += n
+		}
+		var (
+			text      []byte
+			textX     int
+			textN     int
+			textColor uint8
+		)
+		flush := func() {
+			if len(text) == 0 {
+				return
+			}
+			pdfColor(&b, g.colors[textColor])
+			fmt.Fprintf(&b, "BT /F1 %d Tf %s %s Td (%s) Tj ET\n",
+				pdfCellH, pdfNum(pdfMargin+float64(textX)*cellW), pdfNum(bottom), text)
+			text, textN = nil, 0
+		}
+		for x, c := range row {
+			fg, _ := g.attrColors(c)
+			if g.underline(c) {
+				flush()
+				pdfColor(&b, g.colors[fg])
+				pdfRect(&b, pdfMargin+float64(x)*cellW, bottom, cellW, pdfCellH/float64(f.Height))
+			}
+			if textN > 0 && (fg != textColor || x != textX+textN) {
+				flush()
+			}
+			if textN == 0 {
+				if emptyGlyph(f, c.Char) {
+					continue
+				}
+				textX, textColor = x, fg
+			}
+			text = pdfEscape(text, c.Char)
+			textN++
+		}
+		flush()
+	}
+	return b.Bytes()
+}
+
+// emptyGlyph reports whether the glyph of the code has no set pixels.
+func emptyGlyph(f *Font, code byte) bool {
+	for _, row := range f.Glyphs[code] {
+		if row != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// pdfEscape appends the byte to a PDF literal string.
+func pdfEscape(s []byte, c byte) []byte {
+	const del = 0x7f
+	switch {
+	case c == '(', c == ')', c == '\\':
+		return append(s, '\\', c)
+	case c < ' ', c >= del:
+		return fmt.Appendf(s, "\\%03o", c)
+	}
+	return append(s, c)
+}
+
+// pdfColor sets the fill color operator to the color.
+func pdfColor(b *bytes.Buffer, c Color) {
+	r, g, bl := c.RGB()
+	pdfFill(b, r, g, bl)
+}
+
+// pdfFill sets the fill color operator.
+func pdfFill(b *bytes.Buffer, r, g, bl uint8) {
+	const maxValue = 255.0
+	fmt.Fprintf(b, "%s %s %s rg\n",
+		pdfNum(float64(r)/maxValue), pdfNum(float64(g)/maxValue), pdfNum(float64(bl)/maxValue))
+}
+
+// pdfRect appends a filled rectangle operator.
+func pdfRect(b *bytes.Buffer, x, y, w, h float64) {
+	fmt.Fprintf(b, "%s %s %s %s re f\n", pdfNum(x), pdfNum(y), pdfNum(w), pdfNum(h))
+}
+
+// pdfNum returns the number formatted with at most three decimal places.
+func pdfNum(f float64) string {
+	const precision = 3
+	s := strconv.FormatFloat(f, 'f', precision, 64)
+	for s[len(s)-1] == '0' {
+		s = s[:len(s)-1]
+	}
+	if s[len(s)-1] == '.' {
+		s = s[:len(s)-1]
+	}
+	return s
+}
@@ -0,0 +1,35 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_WritePDF() {
+	file, err := os.Open("testdata/test1.bin")
+	if err != nil {
+		panic(err)
+	}
+	defer file.Close()
+	d := binbump.NewDecoder(80, 25, binbump.StandardCGA, nil)
+	if err := d.Read(file); err != nil {
+		panic(err)
+	}
+	var b bytes.Buffer
+	if err := d.Grid().WritePDF(&b); err != nil {
+		panic(err)
+	}
+	fmt.Println(bytes.HasPrefix(b.Bytes(), []byte("%PDF-1.4")))
+	fmt.Println(bytes.Contains(b.Bytes(), []byte("/Count 1")))
+	fmt.Println(bytes.Contains(b.Bytes(), []byte("/Subtype /Type3")))
+	fmt.Println(bytes.Contains(b.Bytes(), []byte("/Differences [ 32 /g20 65 /g41 ")))
+	fmt.Println(bytes.HasSuffix(b.Bytes(), []byte("%%EOF\n")))
+	// Output: true
+	// true
+	// true
+	// true
+	// true
+}
@@ -0,0 +1,36 @@
+package binbump
+
+import (
+	"bufio"
+	"sync"
+)
+
+const (
+	scanBufferSize = 64 * 1024 // size of the token buffer of a Decoder scanner
+	htmlBufferSize = 64 * 1024 // size of the buffered writer of a HTML writer
+)
+
+// Pools of the large buffers used by each read and write, so that servers converting
+// many screens concurrently do not allocate and collect them for every conversion.
+//
+//nolint:gochecknoglobals
+var (
+	scanBuffers = sync.Pool{
+		New: func() any {
+			b := make([]byte, scanBufferSize)
+			return &b
+		},
+	}
+	htmlBuffers = sync.Pool{
+		New: func() any {
+			return bufio.NewWriterSize(nil, htmlBufferSize)
+		},
+	}
+)
+
+// release returns the buffered writer to the pool.
+func (w *htmlWriter) release() {
+	w.Reset(nil)
+	htmlBuffers.Put(w.Writer)
+	w.Writer = nil
+}
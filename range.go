@@ -0,0 +1,25 @@
+package binbump
+
+import (
+	"fmt"
+	"io"
+)
+
+// DecodeRange reads only the rows from fromRow up to, but not including, toRow of the
+// binary dump, by reading the byte range of the rows directly from r, where each row is
+// the width of the Decoder × 2 bytes. This lets viewers lazily load sections of enormous dumps.
+//
+// The first row of the grid is fromRow, and the offsets and rows of any [DecodeError]
+// are relative to the start of the range. A range beyond the end of the data decodes
+// the rows that exist, while a negative or reversed range returns [ErrRange].
+func (d *Decoder) DecodeRange(r io.ReaderAt, fromRow, toRow int) error {
+	if r == nil {
+		return ErrReader
+	}
+	if fromRow < 0 || toRow < fromRow {
+		return fmt.Errorf("decode range %d to %d: %w", fromRow, toRow, ErrRange)
+	}
+	const pair = 2
+	size := int64(d.columns) * pair
+	return d.Read(io.NewSectionReader(r, int64(fromRow)*size, int64(toRow-fromRow)*size))
+}
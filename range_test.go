@@ -0,0 +1,19 @@
+package binbump_test
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleDecoder_DecodeRange() {
+	r := strings.NewReader("A\x07B\x07C\x07D\x07E\x07F\x07")
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.DecodeRange(r, 1, 2); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Print(d.Grid().Transcript())
+	// Output: CD
+}
@@ -0,0 +1,60 @@
+package binbump
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// WriteRTF writes to w the grid as a Rich Text Format document using a colored,
+// monospaced font, for pasting into word processors and documentation that cannot host HTML.
+func (g *Grid) WriteRTF(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	out := bufio.NewWriter(w)
+	out.WriteString(`{\rtf1\ansi\ansicpg437\deff0` +
+		`{\fonttbl{\f0\fmodern\fcharset0 Courier New;}}` + "\n")
+	// the color table index 0 is the auto color, so the palette is offset by 1
+	out.WriteString(`{\colortbl;`)
+	for _, c := range g.colors {
+		r, gr, b := c.RGB()
+		fmt.Fprintf(out, `\red%d\green%d\blue%d;`, r, gr, b)
+	}
+	out.WriteString("}\n" + `\f0\fs20` + "\n")
+	for _, row := range g.rows {
+		attr := -1
+		for _, c := range row {
+			if int(c.Attr) != attr {
+				fg, bg := g.attrColors(c)
+				fmt.Fprintf(out, `\cf%d\chcbpat%d\cb%d `, fg+1, bg+1, bg+1)
+				attr = int(c.Attr)
+			}
+			out.WriteString(rtfEscape(g.rune(c)))
+		}
+		out.WriteString(`\par` + "\n")
+	}
+	out.WriteString("}\n")
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("write rtf flush: %w", err)
+	}
+	return nil
+}
+
+// rtfEscape returns r as RTF text, where control characters are replaced by a space
+// and non-ASCII characters are written as signed 16-bit unicode control words.
+func rtfEscape(r rune) string {
+	const del, maxRune = 0x7f, 0xffff
+	switch {
+	case r == '\\', r == '{', r == '}':
+		return `\` + string(r)
+	case r < ' ', r == del:
+		return " "
+	case r < del:
+		return string(r)
+	case r > maxRune:
+		return "?"
+	}
+	return `\u` + strconv.Itoa(int(int16(r))) + "?" //nolint:gosec
+}
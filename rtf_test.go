@@ -0,0 +1,26 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_WriteRTF() {
+	data := []byte{0x48, 0x1e, 0x69, 0x1e, 0x21, 0x07, 0xdb, 0x04}
+	d := binbump.NewDecoder(0, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	if err := d.Grid().WriteRTF(os.Stdout); err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	// Output: {\rtf1\ansi\ansicpg437\deff0{\fonttbl{\f0\fmodern\fcharset0 Courier New;}}
+	// {\colortbl;\red0\green0\blue0;\red0\green0\blue170;\red0\green170\blue0;\red0\green170\blue170;\red170\green0\blue0;\red170\green0\blue170;\red170\green85\blue0;\red170\green170\blue170;\red85\green85\blue85;\red85\green85\blue255;\red85\green255\blue85;\red85\green255\blue255;\red255\green85\blue85;\red255\green85\blue255;\red255\green255\blue85;\red255\green255\blue255;}
+	// \f0\fs20
+	// \cf15\chcbpat2\cb2 Hi\cf8\chcbpat1\cb1 !\cf5\chcbpat1\cb1 \u9608?\par
+	// }
+}
@@ -0,0 +1,71 @@
+package sauce
+
+import (
+	"bytes"
+	"io"
+	"io/fs"
+	"math"
+)
+
+// TrimReader returns a Reader of the data of r without the metadata at the end of the file,
+// which is the SAUCE record, any COMNT comment block and the EOF character that precedes them,
+// so the data can be used by other tools without rendering the metadata as noise.
+// Data without a SAUCE record is read unchanged.
+//
+// If r has a Size or Stat method, such as [bytes.Reader] and [os.File], only the metadata
+// is read in advance, otherwise r is read into memory until io.EOF.
+// A read error is returned by the Reader.
+func TrimReader(r io.ReaderAt) io.Reader {
+	if r == nil {
+		return errReader{ErrReader}
+	}
+	size := int64(-1)
+	switch v := r.(type) {
+	case interface{ Size() int64 }:
+		size = v.Size()
+	case interface{ Stat() (fs.FileInfo, error) }:
+		if fi, err := v.Stat(); err == nil && fi.Mode().IsRegular() {
+			size = fi.Size()
+		}
+	}
+	if size < 0 {
+		data, err := io.ReadAll(io.NewSectionReader(r, 0, math.MaxInt64))
+		if err != nil {
+			return errReader{err}
+		}
+		return bytes.NewReader(data[:dataSize(data, 0)])
+	}
+	const window int64 = 1 + int64(len(CommentID)) + MaxComments*CommentSize + RecordSize
+	start := max(size-window, 0)
+	tail := make([]byte, size-start)
+	if n, err := r.ReadAt(tail, start); err != nil && n < len(tail) {
+		return errReader{err}
+	}
+	return io.NewSectionReader(r, 0, dataSize(tail, start))
+}
+
+// dataSize returns the length of the data without the metadata,
+// where tail is the end of the data starting at the offset.
+func dataSize(tail []byte, offset int64) int64 {
+	var rec Record
+	if err := rec.UnmarshalBinary(tail); err != nil {
+		return offset + int64(len(tail))
+	}
+	cut := len(tail) - RecordSize
+	if n := len(rec.Comments); n > 0 {
+		cut -= len(CommentID) + n*CommentSize
+	}
+	if cut > 0 && tail[cut-1] == EOF {
+		cut--
+	}
+	return offset + int64(cut)
+}
+
+// errReader is a Reader that always returns the error.
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
@@ -0,0 +1,22 @@
+package sauce_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/bengarrett/binbump/sauce"
+)
+
+func ExampleTrimReader() {
+	var b bytes.Buffer
+	b.WriteString("A\x07B\x1a")
+	r := sauce.Bin(80)
+	r.Comments = []string{"a comment"}
+	if err := sauce.Append(&b, r); err != nil {
+		panic(err)
+	}
+	data, err := io.ReadAll(sauce.TrimReader(bytes.NewReader(b.Bytes())))
+	fmt.Printf("%d %q %v\n", b.Len(), data, err)
+	// Output: 202 "A\aB\x1a" <nil>
+}
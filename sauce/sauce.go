@@ -0,0 +1,253 @@
+// Package sauce reads and writes the SAUCE metadata records that are appended to the end of
+// ANSI art, binary screen dumps and other text mode artworks.
+//
+// The SAUCE specification is documented at https://www.acid.org/info/sauce/sauce.htm
+package sauce
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+var (
+	ErrComments = errors.New("too many comment lines, the maximum is 255")
+	ErrWriter   = errors.New("writer is nil")
+	ErrReader   = errors.New("reader is nil")
+	ErrNoRecord = errors.New("no sauce record found")
+)
+
+const (
+	// RecordSize is the length in bytes of a SAUCE record.
+	RecordSize = 128
+	// CommentSize is the length in bytes of each comment line.
+	CommentSize = 64
+	// EOF is the end-of-file character that separates the data from the metadata.
+	EOF = 0x1a
+	// ID is the signature and version that start a SAUCE record.
+	ID = "SAUCE00"
+	// CommentID is the signature that starts the comment block.
+	CommentID = "COMNT"
+	// MaxComments is the maximum number of comment lines.
+	MaxComments = 255
+)
+
+// DataType is the type of data described by the record.
+type DataType uint8
+
+const (
+	None       DataType = iota // None is undefined data.
+	Character                  // Character is ANSI, ASCII and other text based files.
+	Bitmap                     // Bitmap is a graphic image.
+	Vector                     // Vector is a vector graphic image.
+	Audio                      // Audio is a sound file.
+	BinaryText                 // BinaryText is a raw memory copy of a text mode screen, a .BIN file.
+	XBin                       // XBin is an extended binary text file.
+	Archive                    // Archive is a compressed file.
+	Executable                 // Executable is a program file.
+)
+
+// Character data file types.
+const (
+	ASCII uint8 = iota // ASCII is plain text.
+	ANSi               // ANSi is text with ANSI escape sequences.
+)
+
+// Flags are the TFlags bits, which are only used by the Character and BinaryText data types.
+type Flags uint8
+
+const (
+	// ICEColors is the non-blink mode, where bit 7 of the attribute is a high intensity background.
+	ICEColors Flags = 1 << 0
+	// LetterSpacing8 renders the font using 8 pixel wide characters.
+	LetterSpacing8 Flags = 1 << 1
+	// LetterSpacing9 renders the font using 9 pixel wide characters.
+	LetterSpacing9 Flags = 2 << 1
+	// AspectLegacy stretches the pixels to the 4:3 ratio of a CRT display.
+	AspectLegacy Flags = 1 << 3
+	// AspectSquare renders the pixels as square.
+	AspectSquare Flags = 2 << 3
+)
+
+// Record is the SAUCE metadata of a file.
+// The strings are encoded as IBM Code Page 437 and truncated to the size of their fields.
+type Record struct {
+	Title    string    // Title of the artwork, up to 35 characters.
+	Author   string    // Author is the name or handle of the artist, up to 20 characters.
+	Group    string    // Group or company of the artist, up to 20 characters.
+	Date     time.Time // Date the artwork was created, the zero value is omitted.
+	FileSize uint32    // FileSize is the length of the data without the metadata.
+	DataType DataType  // DataType of the data.
+	FileType uint8     // FileType of the data, which depends on the DataType.
+	TInfo1   uint16    // TInfo1 is usually the width, which depends on the DataType.
+	TInfo2   uint16    // TInfo2 is usually the number of lines, which depends on the DataType.
+	TInfo3   uint16    // TInfo3 depends on the DataType.
+	TInfo4   uint16    // TInfo4 depends on the DataType.
+	Flags    Flags     // Flags of the Character and BinaryText data types.
+	Font     string    // Font is the TInfoS font name, such as "IBM VGA", up to 22 characters.
+	Comments []string  // Comments are up to 255 lines of 64 characters.
+}
+
+// ANSI returns a Record for an ANSI art file with the width and number of lines.
+func ANSI(width, lines int) Record {
+	return Record{
+		DataType: Character,
+		FileType: ANSi,
+		TInfo1:   uint16(min(max(width, 0), 0xffff)), //nolint:gosec,mnd
+		TInfo2:   uint16(min(max(lines, 0), 0xffff)), //nolint:gosec,mnd
+	}
+}
+
+// Bin returns a Record for a binary screen dump with the width in characters,
+// which for the BinaryText data type is stored in the FileType as half the width.
+func Bin(width int) Record {
+	const half, maxType = 2, 0xff
+	return Record{
+		DataType: BinaryText,
+		FileType: uint8(min(max(width/half, 0), maxType)), //nolint:gosec
+	}
+}
+
+// Append writes to w the end-of-file character, the comment block if there are
+// any comments and the SAUCE record, which should follow the data of the file.
+func Append(w io.Writer, r Record) error {
+	if w == nil {
+		return ErrWriter
+	}
+	b, err := r.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(b); err != nil {
+		return fmt.Errorf("sauce append: %w", err)
+	}
+	return nil
+}
+
+// MarshalBinary returns the end-of-file character, the comment block
+// and the 128 byte SAUCE record.
+func (r Record) MarshalBinary() ([]byte, error) {
+	if len(r.Comments) > MaxComments {
+		return nil, fmt.Errorf("%w: %d", ErrComments, len(r.Comments))
+	}
+	var b bytes.Buffer
+	b.WriteByte(EOF)
+	if len(r.Comments) > 0 {
+		b.WriteString(CommentID)
+		for _, line := range r.Comments {
+			b.Write(field(line, CommentSize, ' '))
+		}
+	}
+	const (
+		titleSize  = 35
+		authorSize = 20
+		groupSize  = 20
+		dateSize   = 8
+		fontSize   = 22
+	)
+	b.WriteString(ID)
+	b.Write(field(r.Title, titleSize, ' '))
+	b.Write(field(r.Author, authorSize, ' '))
+	b.Write(field(r.Group, groupSize, ' '))
+	date := ""
+	if !r.Date.IsZero() {
+		date = r.Date.Format("20060102")
+	}
+	b.Write(field(date, dateSize, ' '))
+	_ = binary.Write(&b, binary.LittleEndian, r.FileSize)
+	b.WriteByte(byte(r.DataType))
+	b.WriteByte(r.FileType)
+	_ = binary.Write(&b, binary.LittleEndian, [4]uint16{r.TInfo1, r.TInfo2, r.TInfo3, r.TInfo4})
+	b.WriteByte(byte(len(r.Comments)))
+	b.WriteByte(byte(r.Flags))
+	// unlike the other strings, the font name is padded with NUL characters
+	b.Write(field(r.Font, fontSize, 0))
+	return b.Bytes(), nil
+}
+
+// field returns s encoded as IBM Code Page 437, truncated or padded with the pad byte to size.
+func field(s string, size int, pad byte) []byte {
+	const unknown = '?'
+	b := make([]byte, 0, size)
+	for _, r := range s {
+		if len(b) == size {
+			break
+		}
+		c, ok := charmap.CodePage437.EncodeRune(r)
+		if !ok {
+			c = unknown
+		}
+		b = append(b, c)
+	}
+	return append(b, bytes.Repeat([]byte{pad}, size-len(b))...)
+}
+
+// Decode returns the SAUCE record and any comments found at the end of the file data.
+// Data without a SAUCE record returns [ErrNoRecord].
+func Decode(data []byte) (Record, error) {
+	var r Record
+	if err := r.UnmarshalBinary(data); err != nil {
+		return Record{}, err
+	}
+	return r, nil
+}
+
+// UnmarshalBinary decodes the SAUCE record and any comment block at the end of data,
+// which is either the data of a whole file or the bytes returned by [Record.MarshalBinary].
+// Data without a SAUCE record returns [ErrNoRecord].
+func (r *Record) UnmarshalBinary(data []byte) error {
+	if len(data) < RecordSize {
+		return ErrNoRecord
+	}
+	tail := data[len(data)-RecordSize:]
+	if !bytes.HasPrefix(tail, []byte(ID)) {
+		return ErrNoRecord
+	}
+	const (
+		title, author, group, date = 7, 42, 62, 82
+		fileSize, dataType         = 90, 94
+		fileType, tinfo            = 95, 96
+		comments, flags, font      = 104, 105, 106
+	)
+	rec := Record{
+		Title:    text(tail[title:author], ' '),
+		Author:   text(tail[author:group], ' '),
+		Group:    text(tail[group:date], ' '),
+		FileSize: binary.LittleEndian.Uint32(tail[fileSize:dataType]),
+		DataType: DataType(tail[dataType]),
+		FileType: tail[fileType],
+		TInfo1:   binary.LittleEndian.Uint16(tail[tinfo:]),
+		TInfo2:   binary.LittleEndian.Uint16(tail[tinfo+2:]),
+		TInfo3:   binary.LittleEndian.Uint16(tail[tinfo+4:]),
+		TInfo4:   binary.LittleEndian.Uint16(tail[tinfo+6:]),
+		Flags:    Flags(tail[flags]),
+		Font:     text(tail[font:], 0),
+	}
+	if t, err := time.Parse("20060102", text(tail[date:fileSize], ' ')); err == nil {
+		rec.Date = t
+	}
+	// the comment block is optional and is ignored when it is missing or corrupt
+	if n := int(tail[comments]); n > 0 {
+		start := len(data) - RecordSize - n*CommentSize - len(CommentID)
+		if start >= 0 && bytes.HasPrefix(data[start:], []byte(CommentID)) {
+			block := data[start+len(CommentID) : len(data)-RecordSize]
+			for i := range n {
+				rec.Comments = append(rec.Comments, text(block[i*CommentSize:(i+1)*CommentSize], ' '))
+			}
+		}
+	}
+	*r = rec
+	return nil
+}
+
+// text returns the IBM Code Page 437 field decoded as UTF-8, without the trailing pad bytes.
+func text(b []byte, pad byte) string {
+	b = bytes.TrimRight(b, string([]byte{pad, 0}))
+	s, _ := charmap.CodePage437.NewDecoder().Bytes(b)
+	return string(s)
+}
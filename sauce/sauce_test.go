@@ -0,0 +1,79 @@
+package sauce_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/bengarrett/binbump/sauce"
+)
+
+func ExampleAppend() {
+	data := []byte{0x41, 0x07, 0x42, 0x07}
+	var b bytes.Buffer
+	b.Write(data)
+	r := sauce.Bin(80)
+	r.Title = "Example"
+	r.Author = "binbump"
+	r.Date = time.Date(1994, 5, 6, 0, 0, 0, 0, time.UTC)
+	r.FileSize = uint32(len(data))
+	r.Flags = sauce.ICEColors | sauce.LetterSpacing9
+	r.Font = "IBM VGA"
+	if err := sauce.Append(&b, r); err != nil {
+		panic(err)
+	}
+	p := b.Bytes()
+	fmt.Println(len(p) == len(data)+1+sauce.RecordSize)
+	fmt.Printf("%q\n", p[len(data)+1:len(data)+50])
+	fmt.Printf("%q", p[len(p)-sauce.RecordSize+82:])
+	// Output: true
+	// "SAUCE00Example                            binbump"
+	// "19940506\x04\x00\x00\x00\x05(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x05IBM VGA\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00"
+}
+
+func ExampleAppend_comments() {
+	r := sauce.ANSI(80, 25)
+	r.Comments = []string{"Drawn in TheDraw", "Greets to all"}
+	var b bytes.Buffer
+	if err := sauce.Append(&b, r); err != nil {
+		panic(err)
+	}
+	p := b.Bytes()
+	fmt.Println(len(p) == 1+5+2*sauce.CommentSize+sauce.RecordSize)
+	fmt.Printf("%q", p[1:30])
+	// Output: true
+	// "COMNTDrawn in TheDraw        "
+}
+
+func ExampleDecode() {
+	data, err := os.ReadFile("../testdata/test1.bin")
+	if err != nil {
+		panic(err)
+	}
+	r, err := sauce.Decode(data)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("%q %q %s %d %d %q\n", r.Title, r.Author, r.Date.Format("2006-01-02"), r.DataType, r.FileType, r.Font)
+	_, err = sauce.Decode([]byte("no metadata"))
+	fmt.Println(err)
+	// Output: "" "Anonymous" 2025-11-22 5 40 "IBM VGA"
+	// no sauce record found
+}
+
+func ExampleRecord_UnmarshalBinary() {
+	r := sauce.ANSI(80, 25)
+	r.Title = "Round trip"
+	r.Comments = []string{"Drawn in TheDraw"}
+	b, err := r.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	var got sauce.Record
+	if err := got.UnmarshalBinary(b); err != nil {
+		panic(err)
+	}
+	fmt.Printf("%q %d %d %q\n", got.Title, got.TInfo1, got.TInfo2, got.Comments)
+	// Output: "Round trip" 80 25 ["Drawn in TheDraw"]
+}
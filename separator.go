@@ -0,0 +1,87 @@
+package binbump
+
+import (
+	"html"
+	"strconv"
+	"strings"
+)
+
+// RowSeparator is the markup that separates the rows of the HTML fragment.
+type RowSeparator uint
+
+const (
+	// Newline joins the rows with a newline character, which needs a preformatted
+	// white-space style such as a <pre> element to display the rows as lines.
+	Newline RowSeparator = iota
+	// LineBreak ends each row with a <br> element.
+	LineBreak
+	// RowDiv wraps each row in its own <div class="row"> element.
+	// The span elements of the Optimize mode do not continue across rows.
+	RowDiv
+	// NoSeparator writes the rows without any separators,
+	// leaving the host layout to wrap the rows at the width of the grid.
+	NoSeparator
+)
+
+// rowStart writes the opening markup of a row, where y is the row number.
+func (w *htmlWriter) rowStart(y int) {
+	w.rowSpans = 0
+	w.chunkStart(y)
+	w.indentLine(1)
+	if w.sep != RowDiv {
+		return
+	}
+	w.WriteString(`<div`)
+	if w.rowID != "" {
+		w.WriteString(` id="`)
+		w.WriteString(html.EscapeString(w.rowID))
+		w.num = strconv.AppendInt(w.num[:0], int64(y), 10)
+		w.Write(w.num)
+		w.WriteByte('"')
+	}
+	class := "row"
+	if w.rowClass != "" {
+		class = strings.ReplaceAll(w.rowClass, "%d", strconv.Itoa(y))
+	}
+	w.WriteString(` class="`)
+	w.WriteString(html.EscapeString(class))
+	w.WriteString(`">`)
+}
+
+// rowEnd writes the closing markup of a row, where y is the row number.
+func (w *htmlWriter) rowEnd(y int) {
+	switch w.sep {
+	case Newline:
+		// the indented lines already separate the rows
+		if w.indent == "" {
+			w.WriteByte('\n')
+		}
+	case LineBreak:
+		w.WriteString("<br>")
+	case RowDiv:
+		w.indentLine(1)
+		w.WriteString("</div>")
+	case NoSeparator:
+	}
+	w.chunkEnd(y)
+}
+
+// indentLine starts a new line indented to the level, when the Indent mode is used.
+func (w *htmlWriter) indentLine(level int) {
+	if w.indent == "" {
+		return
+	}
+	w.WriteByte('\n')
+	for range level {
+		w.WriteString(w.indent)
+	}
+}
+
+// spanLine starts a new line for a span element, when the Indent mode is used.
+// The first span of a row continues the line of the row, unless the row is a div element.
+func (w *htmlWriter) spanLine() {
+	if w.rowSpans > 0 || w.sep == RowDiv {
+		w.indentLine(2) //nolint:mnd
+	}
+	w.rowSpans++
+}
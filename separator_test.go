@@ -0,0 +1,79 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleRowSeparator() {
+	data := []byte{0x41, 0x07, 0x42, 0x07, 0x43, 0x07, 0x44, 0x07}
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	for _, sep := range []binbump.RowSeparator{binbump.LineBreak, binbump.RowDiv, binbump.NoSeparator} {
+		d.Separator = sep
+		if err := d.Write(os.Stdout); err != nil {
+			panic(err)
+		}
+		fmt.Println()
+	}
+	d.Optimize = true
+	d.Separator = binbump.RowDiv
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: <div><span style="color:#aaa;background-color:#000;">AB</span><br><span style="color:#aaa;background-color:#000;">CD</span><br></div>
+	// <div><div class="row"><span style="color:#aaa;background-color:#000;">AB</span></div><div class="row"><span style="color:#aaa;background-color:#000;">CD</span></div></div>
+	// <div><span style="color:#aaa;background-color:#000;">AB</span><span style="color:#aaa;background-color:#000;">CD</span></div>
+	// <div><div class="row"><span style="color:#aaa;background-color:#000;">AB</span></div><div class="row"><span style="color:#aaa;background-color:#000;">CD</span></div></div>
+}
+
+func ExampleDecoder_Write_rowID() {
+	data := []byte{0x41, 0x07, 0x42, 0x07, 0x43, 0x07, 0x44, 0x07}
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	d.Separator = binbump.RowDiv
+	d.RowID = "r"
+	d.RowClass = "row line-%d"
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: <div><div id="r1" class="row line-1"><span style="color:#aaa;background-color:#000;">AB</span></div><div id="r2" class="row line-2"><span style="color:#aaa;background-color:#000;">CD</span></div></div>
+}
+
+func ExampleDecoder_Write_indent() {
+	data := []byte{0x41, 0x07, 0x42, 0x1f, 0x43, 0x07, 0x44, 0x07}
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	d.Indent = "  "
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	fmt.Println()
+	d.Separator = binbump.RowDiv
+	if err := d.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: <div>
+	//   <span style="color:#aaa;background-color:#000;">A</span>
+	//     <span style="color:#fff;background-color:#00a;">B</span>
+	//   <span style="color:#aaa;background-color:#000;">CD</span>
+	// </div>
+	// <div>
+	//   <div class="row">
+	//     <span style="color:#aaa;background-color:#000;">A</span>
+	//     <span style="color:#fff;background-color:#00a;">B</span>
+	//   </div>
+	//   <div class="row">
+	//     <span style="color:#aaa;background-color:#000;">CD</span>
+	//   </div>
+	// </div>
+}
@@ -0,0 +1,197 @@
+package binbump
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// gridMagic identifies the data of [Grid.MarshalBinary], followed by the gridVersion byte.
+const (
+	gridMagic   = "BBG"
+	gridVersion = 1
+)
+
+// Flags of the data of [Grid.MarshalBinary].
+const (
+	gridMDA  = 0x01 // the grid uses the monochrome display adapter
+	gridNine = 0x02 // the grid uses 9 pixel wide characters
+	gridFont = 0x04 // the grid has a bitmap font
+)
+
+// MarshalBinary encodes the decoded grid in a compact, versioned binary format, so that
+// services can cache decoded screens and re-render them with different options using
+// [WithGrid], without parsing the source files again.
+//
+// The data contains the width, the charset, the colorset, any font set by [Grid.SetFont],
+// and the rows of cells, where the lengths are unsigned varints.
+// The function of [Grid.SetBlank] is not encoded.
+func (g *Grid) MarshalBinary() ([]byte, error) {
+	const pair = 2
+	b := make([]byte, 0, len(gridMagic)+g.width*len(g.rows)*pair)
+	b = append(b, gridMagic...)
+	b = append(b, gridVersion)
+	b = binary.AppendUvarint(b, uint64(g.width)) //nolint:gosec
+	var flags byte
+	if g.mda {
+		flags |= gridMDA
+	}
+	if g.nine {
+		flags |= gridNine
+	}
+	if g.font != nil {
+		flags |= gridFont
+	}
+	b = append(b, flags)
+	b = appendString(b, charsetName(g.charset))
+	for _, c := range g.colors {
+		b = appendString(b, string(c))
+	}
+	if g.font != nil {
+		b = binary.AppendUvarint(b, uint64(g.font.Width))  //nolint:gosec
+		b = binary.AppendUvarint(b, uint64(g.font.Height)) //nolint:gosec
+		for _, glyph := range g.font.Glyphs {
+			b = append(b, glyph...)
+		}
+	}
+	b = binary.AppendUvarint(b, uint64(len(g.rows)))
+	for _, row := range g.rows {
+		b = binary.AppendUvarint(b, uint64(len(row)))
+		for _, c := range row {
+			b = append(b, c.Char, c.Attr)
+		}
+	}
+	return b, nil
+}
+
+// appendString appends the length of s as an unsigned varint, followed by s.
+func appendString(b []byte, s string) []byte {
+	b = binary.AppendUvarint(b, uint64(len(s)))
+	return append(b, s...)
+}
+
+// UnmarshalBinary decodes the grid from the data created by [Grid.MarshalBinary].
+// Data that is truncated, malformed or of an unknown version returns [ErrGrid].
+func (g *Grid) UnmarshalBinary(data []byte) error {
+	if !bytes.HasPrefix(data, []byte(gridMagic)) || len(data) <= len(gridMagic) {
+		return fmt.Errorf("grid unmarshal: %w", ErrGrid)
+	}
+	if v := data[len(gridMagic)]; v != gridVersion {
+		return fmt.Errorf("grid unmarshal version %d: %w", v, ErrGrid)
+	}
+	r := gridReader{data: data[len(gridMagic)+1:]}
+	n := Grid{width: r.int()}
+	if r.err == nil && n.width == 0 {
+		return fmt.Errorf("grid unmarshal width 0: %w", ErrGrid)
+	}
+	flags := r.byte()
+	cs, err := CharsetByName(string(r.bytes(r.int())))
+	if r.err == nil && err != nil {
+		return fmt.Errorf("grid unmarshal: %w", err)
+	}
+	n.charset = cs
+	for i := range n.colors {
+		n.colors[i] = Color(r.bytes(r.int()))
+	}
+	if r.err == nil && flags&gridFont != 0 {
+		const maxSize = 64
+		f := &Font{Width: r.int(), Height: r.int()}
+		if f.Width <= 0 || f.Height <= 0 || f.Width > maxSize || f.Height > maxSize {
+			return fmt.Errorf("grid unmarshal font size %dx%d: %w", f.Width, f.Height, ErrGrid)
+		}
+		size := (f.Width + 7) / 8 * f.Height
+		for i := range f.Glyphs {
+			f.Glyphs[i] = bytes.Clone(r.bytes(size))
+		}
+		n.font = f
+	}
+	rows := r.int()
+	if r.err == nil && rows > len(r.data) {
+		return fmt.Errorf("grid unmarshal %d rows: %w", rows, ErrGrid)
+	}
+	n.rows = make([][]Cell, 0, rows)
+	for range rows {
+		cells := r.cells()
+		row := make([]Cell, 0, len(cells)/2) //nolint:mnd
+		for i := 0; i+1 < len(cells); i += 2 {
+			row = append(row, Cell{Char: cells[i], Attr: cells[i+1]})
+		}
+		n.rows = append(n.rows, row)
+	}
+	if r.err != nil {
+		return fmt.Errorf("grid unmarshal: %w", r.err)
+	}
+	if err := n.colors.Validate(); err != nil {
+		return fmt.Errorf("grid unmarshal: %w", err)
+	}
+	n.mda = flags&gridMDA != 0
+	n.nine = flags&gridNine != 0
+	*g = n
+	return nil
+}
+
+// WithGrid sets the grid of the Decoder, such as a grid decoded by [Grid.UnmarshalBinary],
+// so that it can be rendered with the settings of the Decoder without reading the data.
+// The width of the Decoder is set to the width of the grid, and a nil grid is ignored.
+func WithGrid(g *Grid) Option {
+	return func(d *Decoder) {
+		if g != nil {
+			d.grid = g
+			d.columns = g.width
+		}
+	}
+}
+
+// gridReader reads the data of [Grid.MarshalBinary], where the first error is kept
+// and any further reads return zero values.
+type gridReader struct {
+	data []byte
+	err  error
+}
+
+// int reads an unsigned varint.
+func (r *gridReader) int() int {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.data)
+	if n <= 0 || v > math.MaxInt32 {
+		r.err = ErrGrid
+		return 0
+	}
+	r.data = r.data[n:]
+	return int(v) //nolint:gosec
+}
+
+// cells reads the length of a row and the character and attribute pairs of its cells.
+// The length is checked against the remaining data before it is doubled,
+// so that it cannot overflow.
+func (r *gridReader) cells() []byte {
+	const pair = 2
+	n := r.int()
+	if n > len(r.data)/pair {
+		r.err = ErrGrid
+		return nil
+	}
+	return r.bytes(n * pair)
+}
+
+// byte reads a byte.
+func (r *gridReader) byte() byte {
+	if b := r.bytes(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+// bytes reads n bytes, which are a slice of the data.
+func (r *gridReader) bytes(n int) []byte {
+	if r.err != nil || n < 0 || n > len(r.data) {
+		r.err = ErrGrid
+		return nil
+	}
+	b := r.data[:n]
+	r.data = r.data[n:]
+	return b
+}
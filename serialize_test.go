@@ -0,0 +1,57 @@
+package binbump_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_MarshalBinary() {
+	d := binbump.NewDecoder(2, 0, binbump.RevisedCGA, nil)
+	if err := d.Read(strings.NewReader("H\x07i\x1e")); err != nil {
+		log.Fatal(err)
+	}
+	data, err := d.Grid().MarshalBinary()
+	if err != nil {
+		log.Fatal(err)
+	}
+	var g binbump.Grid
+	if err := g.UnmarshalBinary(data); err != nil {
+		log.Fatal(err)
+	}
+	r := binbump.NewDecoder(0, 0, binbump.StandardCGA, nil, binbump.WithGrid(&g))
+	if err := r.Write(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println()
+	err = g.UnmarshalBinary(data[:len(data)-1])
+	fmt.Println(errors.Is(err, binbump.ErrGrid))
+	// Output: <div><span style="color:#c4c4c4;background-color:#000;">H</span><span style="color:#f3f34e;background-color:#0000c4;">i</span>
+	// </div>
+	// true
+}
+
+func ExampleGrid_UnmarshalBinary_malformed() {
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.Read(strings.NewReader("H\x07i\x1e")); err != nil {
+		log.Fatal(err)
+	}
+	data, err := d.Grid().MarshalBinary()
+	if err != nil {
+		log.Fatal(err)
+	}
+	// replace the row of two cells with a row length that overflows when doubled
+	const row = 5
+	data = binary.AppendUvarint(data[:len(data)-row], math.MaxInt32)
+	data = append(data, make([]byte, 100)...)
+	var g binbump.Grid
+	err = g.UnmarshalBinary(data)
+	fmt.Println(errors.Is(err, binbump.ErrGrid))
+	// Output: true
+}
@@ -0,0 +1,109 @@
+package binbump
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"strconv"
+)
+
+// WriteSixel writes to w the grid as a Sixel graphics escape sequence rendered by
+// [Grid.Image], so that terminals such as xterm, mlterm and foot can display
+// a pixel-accurate version of the screen inline.
+func (g *Grid) WriteSixel(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	out := bufio.NewWriter(w)
+	writeSixel(out, g.Image())
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("write sixel flush: %w", err)
+	}
+	return nil
+}
+
+// writeSixel writes the image as a Sixel sequence, with a color register for each
+// color of the image. The image must use no more than 256 colors,
+// which is always true for the images of the grid.
+func writeSixel(out *bufio.Writer, img *image.RGBA) {
+	const (
+		bandH    = 6    // pixel rows in a band of sixels
+		sixelOff = 0x3f // offset of the sixel data characters
+		percent  = 100
+		maxValue = 255
+	)
+	b := img.Bounds()
+	// P2 of 1 keeps the pixels without a color register transparent
+	out.WriteString("\x1bP0;1;0q")
+	fmt.Fprintf(out, "\"1;1;%d;%d", b.Dx(), b.Dy())
+	registers := map[color.RGBA]int{}
+	var colors []color.RGBA // colors are ordered by register
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c := img.RGBAAt(x, y)
+			if _, ok := registers[c]; ok {
+				continue
+			}
+			registers[c] = len(colors)
+			colors = append(colors, c)
+			fmt.Fprintf(out, "#%d;2;%d;%d;%d", registers[c],
+				int(c.R)*percent/maxValue, int(c.G)*percent/maxValue, int(c.B)*percent/maxValue)
+		}
+	}
+	row := make([]byte, b.Dx())
+	for top := b.Min.Y; top < b.Max.Y; top += bandH {
+		// each color of the band is drawn as a separate pass over the same pixels
+		used := map[color.RGBA]bool{}
+		for y := top; y < min(top+bandH, b.Max.Y); y++ {
+			for x := b.Min.X; x < b.Max.X; x++ {
+				used[img.RGBAAt(x, y)] = true
+			}
+		}
+		first := true
+		for reg, c := range colors {
+			if !used[c] {
+				continue
+			}
+			for x := b.Min.X; x < b.Max.X; x++ {
+				var bits byte
+				for i := range bandH {
+					if y := top + i; y < b.Max.Y && img.RGBAAt(x, y) == c {
+						bits |= 1 << i
+					}
+				}
+				row[x-b.Min.X] = sixelOff + bits
+			}
+			if !first {
+				out.WriteByte('$')
+			}
+			first = false
+			out.WriteString("#" + strconv.Itoa(reg))
+			sixelRLE(out, bytes.TrimRight(row, string(rune(sixelOff))))
+		}
+		out.WriteByte('-')
+	}
+	out.WriteString("\x1b\\")
+}
+
+// sixelRLE writes the sixel data characters, using the repeat introducer for runs.
+func sixelRLE(out *bufio.Writer, row []byte) {
+	const minRepeat = 4 // shorter runs are smaller written as is
+	for i := 0; i < len(row); {
+		n := 1
+		for i+n < len(row) && row[i+n] == row[i] {
+			n++
+		}
+		if n >= minRepeat {
+			out.WriteString("!" + strconv.Itoa(n))
+			out.WriteByte(row[i])
+		} else {
+			for range n {
+				out.WriteByte(row[i])
+			}
+		}
+		i += n
+	}
+}
@@ -0,0 +1,23 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_WriteSixel() {
+	data := []byte{0xdb, 0x04, 0x20, 0x1e}
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	var b strings.Builder
+	if err := d.Grid().WriteSixel(&b); err != nil {
+		panic(err)
+	}
+	fmt.Printf("%q", b.String())
+	// Output: "\x1bP0;1;0q\"1;1;16;16#0;2;66;0;0#1;2;0;0;66#0!8~$#1!8?!8~-#0!8~$#1!8?!8~-#0!8N$#1!8?!8N-\x1b\\"
+}
@@ -0,0 +1,113 @@
+package binbump
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"image"
+	"io"
+	"unicode"
+)
+
+// WriteSVG writes to w the grid as a scalable SVG image, with each cell 8 by 16 units
+// drawn as text on a colored rectangle.
+//
+// The text uses the monospace font of the viewer, stretched to the width of the cells,
+// while the block, shade and box-drawing characters of the charset are drawn as shapes
+// that match the [Grid.Image] renderer, so the image does not rely on a DOS font.
+func (g *Grid) WriteSVG(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	out := bufio.NewWriter(w)
+	width, height := g.width*cellW, len(g.rows)*cellH
+	fmt.Fprintf(out, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" `+
+		`font-family="monospace" font-size="%d" xml:space="preserve">`+"\n",
+		width, height, width, height, svgFontSize)
+	for y, row := range g.rows {
+		g.svgRow(out, y*cellH, row)
+	}
+	out.WriteString("</svg>\n")
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("write svg flush: %w", err)
+	}
+	return nil
+}
+
+const (
+	svgFontSize = 13 // font size in units, where the advance of most monospace fonts is 8 units
+	svgBaseline = 12 // baseline offset of the text from the top of the cell
+)
+
+// svgRow writes the shapes and text of the row of cells at the top offset.
+func (g *Grid) svgRow(out *bufio.Writer, top int, row []Cell) {
+	rect := func(r image.Rectangle, c Color) {
+		fmt.Fprintf(out, `<rect x="%d" y="%d" width="%d" height="%d" fill="#%s"/>`+"\n",
+			r.Min.X, r.Min.Y, r.Dx(), r.Dy(), c.hex())
+	}
+	// backgrounds are drawn first as runs of the same color
+	for x := 0; x < len(row); {
+		_, bg := g.attrColors(row[x])
+		n := 1
+		for ; x+n < len(row); n++ {
+			if _, next := g.attrColors(row[x+n]); next != bg {
+				break
+			}
+		}
+		rect(image.Rect(x*cellW, top, (x+n)*cellW, top+cellH), g.colors[bg])
+		x += n
+	}
+	var (
+		text      []rune
+		textX     int
+		textColor uint8
+	)
+	flush := func() {
+		for len(text) > 0 && text[len(text)-1] == ' ' {
+			text = text[:len(text)-1]
+		}
+		if len(text) == 0 {
+			return
+		}
+		fmt.Fprintf(out, `<text x="%d" y="%d" fill="#%s" textLength="%d" lengthAdjust="spacingAndGlyphs">`,
+			textX*cellW, top+svgBaseline, g.colors[textColor].hex(), len(text)*cellW)
+		_ = xml.EscapeText(out, []byte(string(text)))
+		out.WriteString("</text>\n")
+		text = nil
+	}
+	for x, c := range row {
+		r := g.rune(c)
+		fg, bg := g.attrColors(c)
+		pt := image.Pt(x*cellW, top)
+		if g.underline(c) {
+			rect(image.Rect(0, cellH-1, cellW, cellH).Add(pt), g.colors[fg])
+		}
+		if blk, ok := blockElements[r]; ok {
+			flush()
+			fr, fgr, fb := g.colors[fg].RGB()
+			br, bgr, bb := g.colors[bg].RGB()
+			mixed := Color(fmt.Sprintf("%02x%02x%02x",
+				mix(fr, br, blk.shade), mix(fgr, bgr, blk.shade), mix(fb, bb, blk.shade)))
+			rect(image.Rect(int(blk.x*cellW), int(blk.y*cellH),
+				int((blk.x+blk.w)*cellW), int((blk.y+blk.h)*cellH)).Add(pt), mixed)
+			continue
+		}
+		if a, ok := boxDrawing[r]; ok {
+			flush()
+			drawArms(func(r image.Rectangle) { rect(r.Add(pt), g.colors[fg]) }, a)
+			continue
+		}
+		r = textRune(r)
+		if len(text) > 0 && fg != textColor && !unicode.IsSpace(r) {
+			flush()
+		}
+		if len(text) == 0 {
+			if unicode.IsSpace(r) {
+				continue
+			}
+			textX, textColor = x, fg
+		}
+		text = append(text, r)
+	}
+	flush()
+}
@@ -0,0 +1,26 @@
+package binbump_test
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_WriteSVG() {
+	data := []byte{0x48, 0x1e, 0x69, 0x1e, 0x21, 0x07, 0xdb, 0x04}
+	d := binbump.NewDecoder(4, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	if err := d.Grid().WriteSVG(os.Stdout); err != nil {
+		panic(err)
+	}
+	// Output: <svg xmlns="http://www.w3.org/2000/svg" width="32" height="16" viewBox="0 0 32 16" font-family="monospace" font-size="13" xml:space="preserve">
+	// <rect x="0" y="0" width="16" height="16" fill="#0000aa"/>
+	// <rect x="16" y="0" width="16" height="16" fill="#000000"/>
+	// <text x="0" y="12" fill="#ffff55" textLength="16" lengthAdjust="spacingAndGlyphs">Hi</text>
+	// <text x="16" y="12" fill="#aaaaaa" textLength="8" lengthAdjust="spacingAndGlyphs">!</text>
+	// <rect x="24" y="0" width="8" height="16" fill="#aa0000"/>
+	// </svg>
+}
@@ -0,0 +1,61 @@
+package binbump
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// Tiles slices the grid into side-by-side tiles of the number of columns, from left to right,
+// where the last tile holds the remaining columns. Each tile has the rows of the grid and
+// the same charset, colors and font. If columns <= 0 or columns >= the width of the grid,
+// the grid is returned as the only tile. The cells of the tiles are shared with the grid.
+func (g *Grid) Tiles(columns int) []*Grid {
+	if columns <= 0 || columns >= g.width {
+		return []*Grid{g}
+	}
+	tiles := make([]*Grid, 0, (g.width+columns-1)/columns)
+	for left := 0; left < g.width; left += columns {
+		t := *g
+		t.width = min(columns, g.width-left)
+		t.rows = make([][]Cell, len(g.rows))
+		for y, row := range g.rows {
+			t.rows[y] = row[min(left, len(row)):min(left+t.width, len(row)):min(left+t.width, len(row))]
+		}
+		tiles = append(tiles, &t)
+	}
+	return tiles
+}
+
+// WriteTiles writes to w the HTML fragments of the [Grid.Tiles] of the number of columns,
+// rendered side-by-side in a horizontal scroll container, so that ultra-wide canvases
+// of 160 or more columns can be viewed on narrow screens. Every tile has the rows of the grid
+// and the container sets a line-height of 1, so the rows of the tiles stay aligned.
+//
+// The [Decoder.Stats] are the sum of the tiles, and the cache of [WithCache] is not used.
+func (d *Decoder) WriteTiles(w io.Writer, columns int) error {
+	if w == nil {
+		w = io.Discard
+	}
+	out := bufio.NewWriter(w)
+	out.WriteString(`<div class="tiles" style="display:flex;align-items:flex-start;overflow-x:auto;` +
+		`white-space:pre;line-height:1;">`)
+	grid := d.grid
+	defer func() { d.grid = grid }()
+	var stats Stats
+	for _, t := range grid.Tiles(columns) {
+		d.grid = t
+		if err := d.write(out); err != nil {
+			return err
+		}
+		stats.Cells += d.stats.Cells
+		stats.Spans += d.stats.Spans
+		stats.Bytes += d.stats.Bytes
+	}
+	out.WriteString("</div>")
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("write tiles flush: %w", err)
+	}
+	d.stats = stats
+	return nil
+}
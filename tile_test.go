@@ -0,0 +1,36 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_Tiles() {
+	data := []byte("A\x07B\x07C\x07D\x07E\x07F\x07G\x07H\x07I\x07J\x07")
+	d := binbump.NewDecoder(5, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	for _, t := range d.Grid().Tiles(2) {
+		fmt.Printf("%q\n", t.Transcript())
+	}
+	// Output: "AB\nFG\n"
+	// "CD\nHI\n"
+	// "E\nJ\n"
+}
+
+func ExampleDecoder_WriteTiles() {
+	data := []byte("A\x07B\x07C\x07D\x07")
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	d.Separator = binbump.LineBreak
+	if err := d.WriteTiles(os.Stdout, 1); err != nil {
+		panic(err)
+	}
+	// Output: <div class="tiles" style="display:flex;align-items:flex-start;overflow-x:auto;white-space:pre;line-height:1;"><div><span style="color:#aaa;background-color:#000;">A</span><br><span style="color:#aaa;background-color:#000;">C</span><br></div><div><span style="color:#aaa;background-color:#000;">B</span><br><span style="color:#aaa;background-color:#000;">D</span><br></div></div>
+}
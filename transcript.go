@@ -0,0 +1,64 @@
+package binbump
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// Transcript returns the readable text content of the binary dump found in the Reader,
+// for use in search indexing or alt-text generation.
+// It assumes the Reader is using IBM Code Page 437 encoding.
+// Any SAUCE metadata is removed with [TrimMetadata], and the width is read from
+// the SAUCE record of a binary text file, or otherwise guessed with [GuessWidth].
+//
+// Runs of block-graphics, box-drawing, control and space characters are collapsed
+// into a single space, and rows without any readable text are skipped.
+func Transcript(r io.Reader) (string, error) {
+	if r == nil {
+		return "", ErrReader
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", fmt.Errorf("transcript: %w", err)
+	}
+	d := NewDecoder(dataWidth(data), 0, StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(TrimMetadata(data))); err != nil {
+		return "", err
+	}
+	return d.Grid().Transcript(), nil
+}
+
+// Transcript returns the readable text content of the grid.
+// Each row containing text is returned as a newline terminated line.
+func (g *Grid) Transcript() string {
+	var sb strings.Builder
+	for _, row := range g.rows {
+		runes := make([]rune, 0, len(row))
+		for _, c := range row {
+			runes = append(runes, g.rune(c))
+		}
+		line := strings.FieldsFunc(string(runes), graphic)
+		if len(line) == 0 {
+			continue
+		}
+		sb.WriteString(strings.Join(line, " "))
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// graphic reports whether r is a space, control, box-drawing, block element
+// or geometric shape character that has no meaning as readable text.
+func graphic(r rune) bool {
+	const (
+		boxDrawing      = 0x2500
+		geometricShapes = 0x25ff
+	)
+	if r >= boxDrawing && r <= geometricShapes {
+		return true
+	}
+	return unicode.IsSpace(r) || unicode.IsControl(r) || r == unicode.ReplacementChar
+}
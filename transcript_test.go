@@ -0,0 +1,52 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/bengarrett/binbump"
+	"github.com/bengarrett/binbump/sauce"
+)
+
+func ExampleTranscript() {
+	data := []byte{
+		0xdb, 0x04, 0xdb, 0x04, 0x20, 0x07, 0x48, 0x07, 0x49, 0x07,
+		0x20, 0x07, 0xb1, 0x01, 0xb1, 0x01, 0x21, 0x0f, 0x00, 0x00,
+	}
+	r := bytes.NewReader(data)
+	s, _ := binbump.Transcript(r)
+	fmt.Printf("%q", s)
+	// Output: "HI !\n"
+}
+
+func ExampleGrid_Transcript() {
+	file, err := os.Open("testdata/test1.bin")
+	if err != nil {
+		panic(err)
+	}
+	defer file.Close()
+	d := binbump.NewDecoder(80, 25, binbump.StandardCGA, nil)
+	if err := d.Read(file); err != nil {
+		panic(err)
+	}
+	fmt.Print(d.Grid().Transcript())
+	// Output: THIS IS A Φ TEST Φ
+}
+
+func ExampleTranscript_sauce() {
+	var b bytes.Buffer
+	for _, s := range []string{"HELLO", "WORLD"} {
+		row := bytes.Repeat([]byte{0x20, 0x07}, 80)
+		for i := range len(s) {
+			row[i*2] = s[i]
+		}
+		b.Write(row)
+	}
+	if err := sauce.Append(&b, sauce.Bin(80)); err != nil {
+		panic(err)
+	}
+	s, _ := binbump.Transcript(&b)
+	fmt.Printf("%q", s)
+	// Output: "HELLO\nWORLD\n"
+}
@@ -0,0 +1,40 @@
+package binbump
+
+import (
+	"bytes"
+
+	"github.com/bengarrett/binbump/sauce"
+)
+
+// TrimMetadata returns data truncated before any SAUCE metadata found in the tail,
+// which otherwise renders as rows of noise at the bottom of the screen.
+//
+// The tail is searched for the SAUCE00 signature and the COMNT comment block,
+// even when the record is corrupt or misplaced, and the data is cut at the earliest
+// match along with any 0x1A end-of-file markers that precede it.
+// Without any metadata, a lone 0x1A end-of-file marker that follows the whole cells
+// of the data is removed, otherwise data is returned unchanged.
+func TrimMetadata(data []byte) []byte {
+	const window = sauce.RecordSize + len(sauce.CommentID) + sauce.MaxComments*sauce.CommentSize + 1
+	start := max(0, len(data)-window)
+	tail := data[start:]
+	cut := bytes.LastIndex(tail, []byte(sauce.ID))
+	if cut < 0 {
+		cut = len(tail)
+	}
+	if i := bytes.LastIndex(tail[:cut], []byte(sauce.CommentID)); i >= 0 {
+		cut = i
+	}
+	if cut == len(tail) {
+		// an even length is whole cells, where the final byte is an attribute
+		if n := len(data); n%2 == 1 && data[n-1] == sauce.EOF {
+			return data[:n-1]
+		}
+		return data
+	}
+	cut += start
+	for cut > 0 && data[cut-1] == sauce.EOF {
+		cut--
+	}
+	return data[:cut]
+}
@@ -0,0 +1,41 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+	"github.com/bengarrett/binbump/sauce"
+)
+
+func ExampleTrimMetadata() {
+	var b bytes.Buffer
+	b.Write([]byte{0x48, 0x07, 0x49, 0x07})
+	r := sauce.Bin(4)
+	r.Comments = []string{"A comment"}
+	if err := sauce.Append(&b, r); err != nil {
+		panic(err)
+	}
+	fmt.Println(b.Len(), len(binbump.TrimMetadata(b.Bytes())))
+	// Output: 202 4
+}
+
+func ExampleWithTrimMetadata() {
+	var b bytes.Buffer
+	b.Write([]byte{0x48, 0x07, 0x49, 0x07})
+	if err := sauce.Append(&b, sauce.Bin(2)); err != nil {
+		panic(err)
+	}
+	d := binbump.NewDecoder(2, 0, binbump.StandardCGA, nil, binbump.WithTrimMetadata())
+	if err := d.Read(&b); err != nil {
+		panic(err)
+	}
+	fmt.Println(d.Grid().Height(), "row")
+	// Output: 1 row
+}
+
+func ExampleTrimMetadata_eof() {
+	data := []byte{0x48, 0x07, 0x49, 0x1a, sauce.EOF}
+	fmt.Printf("% x\n", binbump.TrimMetadata(data))
+	// Output: 48 07 49 1a
+}
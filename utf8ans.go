@@ -0,0 +1,66 @@
+package binbump
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+)
+
+// controlGlyphs are the Unicode characters of the glyphs that the IBM PC displays for the
+// control codes 0x00 to 0x1F, where NUL is a space.
+//
+//nolint:gochecknoglobals
+var controlGlyphs = [32]rune{
+	' ', '☺', '☻', '♥', '♦', '♣', '♠', '•', '◘', '○', '◙', '♂', '♀', '♪', '♫', '☼',
+	'►', '◄', '↕', '‼', '¶', '§', '▬', '↨', '↑', '↓', '→', '←', '∟', '↔', '▲', '▼',
+}
+
+// WriteUTF8ANS writes to w the grid as a "utf8ans" file of UTF-8 text with select graphic
+// rendition escape sequences, as used by modern terminal art viewers, so screens can be used
+// with contemporary terminal art tooling. The characters of the charset are converted to
+// Unicode, including the glyphs displayed for the control codes and the 0x7F house,
+// and the colors are written as by [Grid.WriteANS], with the intense colors using bold.
+//
+// Blank cells at the end of a row are trimmed, and each row ends with a newline,
+// after an attribute reset when the colors are not the default gray on black.
+func (g *Grid) WriteUTF8ANS(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	out := bufio.NewWriter(w)
+	for _, row := range g.rows {
+		end := len(row)
+		for end > 0 && g.ansBlank(row[end-1]) {
+			end--
+		}
+		state := ansDefault
+		for _, c := range row[:end] {
+			out.WriteString(state.sgr(c))
+			fg, bg := c.Colors()
+			state = ansState{fg: fg, bg: bg, bold: fg >= 8, blink: c.Blink()}
+			out.WriteRune(g.glyphRune(c))
+		}
+		if state != ansDefault {
+			out.WriteString("\x1b[0m")
+		}
+		out.WriteByte('\n')
+	}
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("write utf8ans flush: %w", err)
+	}
+	return nil
+}
+
+// glyphRune returns the Unicode character of the glyph displayed for the cell, where the
+// control codes are the glyphs of the IBM PC instead of control characters.
+func (g *Grid) glyphRune(c Cell) rune {
+	const del, house = 0x7f, '⌂'
+	r := g.rune(c)
+	switch {
+	case r < ' ':
+		return controlGlyphs[r]
+	case r == del:
+		return house
+	}
+	return r
+}
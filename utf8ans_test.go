@@ -0,0 +1,22 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGrid_WriteUTF8ANS() {
+	data := []byte("\x01\x0e\xdb\x07 \x07 \x07\xb0\x1c \x07")
+	d := binbump.NewDecoder(3, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	var b bytes.Buffer
+	if err := d.Grid().WriteUTF8ANS(&b); err != nil {
+		panic(err)
+	}
+	fmt.Printf("%q", b.String())
+	// Output: "\x1b[1;33m☺\x1b[0m█\n \x1b[1;31;44m░\x1b[0m\n"
+}
@@ -0,0 +1,196 @@
+package binbump
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+)
+
+// viewer is the template of the HTML document of [Decoder.WriteViewer].
+//
+//nolint:gochecknoglobals
+var viewer = template.Must(template.New("viewer").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+{{- with .FontFace}}
+@font-face{ {{- .}}}
+{{- end}}
+body{background:#000;color:#aaa;font-family:sans-serif;margin:0}
+nav{align-items:center;background:#222;display:flex;gap:.5em;padding:.5em}
+output{font-family:monospace;margin-left:auto}
+main{overflow:auto}
+pre{display:inline-block;margin:0;transform-origin:0 0;{{.Font}}}
+.blink{animation:blink 1s steps(1) infinite}
+@keyframes blink{50%{color:transparent}}
+</style>
+</head>
+<body>
+<nav>
+<button id="zoom-out" type="button" title="Zoom out">&minus;</button>
+<button id="zoom-in" type="button" title="Zoom in">+</button>
+<select id="palette" title="Palette">
+{{- range .Palettes}}
+<option>{{.}}</option>
+{{- end}}
+</select>
+<label><input id="blink" type="checkbox"> Blink</label>
+<output id="cell"></output>
+</nav>
+<main>
+<pre id="screen">{{.Fragment}}</pre>
+</main>
+<script>
+(function () {
+  "use strict";
+  const data = {{.Data}};
+  const screen = document.getElementById("screen");
+  const palette = document.getElementById("palette");
+  const blink = document.getElementById("blink");
+  const cell = document.getElementById("cell");
+  const entities = {"&": "&amp;", "<": "&lt;", ">": "&gt;"};
+  let zoom = 1;
+  function escape(s) {
+    return s.replace(/[&<>]/g, function (c) { return entities[c]; });
+  }
+  function render() {
+    const colors = data.palettes[palette.value];
+    let html = "";
+    for (const row of data.grid.rows) {
+      let open = "", text = "";
+      for (const c of row) {
+        let tag = "<span style=\"color:#" + colors[c.fg] + ";background-color:#" + colors[c.bg] + "\"";
+        if (blink.checked && c.blink) {
+          tag += " class=\"blink\"";
+        }
+        tag += ">";
+        if (tag !== open && text !== "") {
+          html += open + escape(text) + "</span>";
+          text = "";
+        }
+        open = tag;
+        text += c.ch;
+      }
+      if (text !== "") {
+        html += open + escape(text) + "</span>";
+      }
+      html += "\n";
+    }
+    screen.innerHTML = html;
+  }
+  function scale(factor) {
+    zoom = Math.min(Math.max(zoom * factor, 0.25), 8);
+    screen.style.transform = "scale(" + zoom + ")";
+  }
+  document.getElementById("zoom-in").addEventListener("click", function () { scale(1.25); });
+  document.getElementById("zoom-out").addEventListener("click", function () { scale(0.8); });
+  palette.addEventListener("change", render);
+  blink.addEventListener("change", render);
+  screen.addEventListener("mousemove", function (e) {
+    const rows = data.grid.rows;
+    const r = screen.getBoundingClientRect();
+    const x = Math.floor((e.clientX - r.left) / (r.width / data.grid.width));
+    const y = Math.floor((e.clientY - r.top) / (r.height / rows.length));
+    const c = rows[y] && rows[y][x];
+    if (!c) {
+      cell.value = "";
+      return;
+    }
+    const code = c.ch.codePointAt(0).toString(16).toUpperCase().padStart(4, "0");
+    cell.value = "row " + (y + 1) + ", column " + (x + 1) + ": U+" + code +
+      " fg " + c.fg + " bg " + c.bg + (c.blink ? " blink" : "");
+  });
+  screen.addEventListener("mouseleave", function () { cell.value = ""; });
+}());
+</script>
+</body>
+</html>
+`))
+
+// viewerPalette is the name of the colorset of the grid in the viewer.
+const viewerPalette = "current"
+
+// WriteViewer writes to w a complete HTML document of the decoded screen with a small
+// self-contained JavaScript viewer, as a turn-key artwork viewer for embedding.
+// The viewer has buttons to zoom, a list to switch between the colorset of the grid
+// and the registered palettes, a toggle that animates the cells with the blink bit,
+// and a readout of the character and colors of the cell under the pointer.
+//
+// The screen is the HTML fragment of [Decoder.Write], so it is viewable without
+// JavaScript, and it is redrawn from the cells of [Grid.MarshalJSON] when the palette
+// or blink are changed. The font of [Decoder.WriteDocument] is used.
+func (d *Decoder) WriteViewer(w io.Writer) error {
+	if w == nil {
+		w = io.Discard
+	}
+	var frag bytes.Buffer
+	if err := d.Write(&frag); err != nil {
+		return err
+	}
+	grid, err := d.grid.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("write viewer: %w", err)
+	}
+	names, palettes := d.viewerPalettes()
+	js, err := json.Marshal(struct {
+		Grid     json.RawMessage     `json:"grid"`
+		Palettes map[string][]string `json:"palettes"`
+	}{grid, palettes})
+	if err != nil {
+		return fmt.Errorf("write viewer: %w", err)
+	}
+	face, err := d.fontFace()
+	if err != nil {
+		return fmt.Errorf("write viewer: %w", err)
+	}
+	data := struct {
+		Title    string
+		FontFace template.CSS
+		Font     template.CSS
+		Palettes []string
+		Fragment template.HTML
+		Data     template.JS
+	}{
+		Title:    "binbump",
+		FontFace: face,
+		Font:     template.CSS(d.documentFont()),
+		Palettes: names,
+		Fragment: template.HTML(frag.String()), //nolint:gosec
+		Data:     template.JS(js),              //nolint:gosec
+	}
+	if r := d.record; r != nil && r.Title != "" {
+		data.Title = r.Title
+	}
+	out := bufio.NewWriter(w)
+	if err := viewer.Execute(out, data); err != nil {
+		return fmt.Errorf("write viewer: %w", err)
+	}
+	if err := out.Flush(); err != nil {
+		return fmt.Errorf("write viewer flush: %w", err)
+	}
+	return nil
+}
+
+// viewerPalettes returns the names and the six-digit colors of the colorset of the grid
+// and the registered palettes, except for the monochrome display adapter, whose
+// attributes are not colors.
+func (d *Decoder) viewerPalettes() ([]string, map[string][]string) {
+	names := []string{viewerPalette}
+	palettes := map[string][]string{viewerPalette: d.grid.colors.hexes()}
+	for _, name := range Palettes() {
+		pal, err := PaletteByName(name)
+		if err != nil || pal == MDA {
+			continue
+		}
+		g := &Grid{}
+		g.setPalette(pal)
+		names = append(names, name)
+		palettes[name] = g.colors.hexes()
+	}
+	return names, palettes
+}
@@ -0,0 +1,30 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleDecoder_WriteViewer() {
+	data := []byte{0x48, 0x9e, 0x69, 0x9e}
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	var b bytes.Buffer
+	if err := d.WriteViewer(&b); err != nil {
+		panic(err)
+	}
+	for line := range strings.Lines(b.String()) {
+		if strings.HasPrefix(line, "<option>c") || strings.HasPrefix(line, "<pre") {
+			fmt.Print(line)
+		}
+	}
+	// Output: <option>current</option>
+	// <option>cga</option>
+	// <option>composite</option>
+	// <pre id="screen"><div><span style="color:#ff5;background-color:#00a;">Hi</span>
+}
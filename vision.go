@@ -0,0 +1,85 @@
+package binbump
+
+import "math"
+
+// Vision is a color vision deficiency used to simulate how a palette is seen.
+type Vision uint
+
+const (
+	// Protanopia is the absence of the red sensitive cones.
+	Protanopia Vision = iota
+	// Deuteranopia is the absence of the green sensitive cones.
+	Deuteranopia
+	// Tritanopia is the absence of the blue sensitive cones.
+	Tritanopia
+)
+
+// visionMatrix are the linear RGB transforms of the color vision deficiencies, from
+// "A Physiologically-based Model for Simulation of Color Vision Deficiency"
+// by Machado, Oliveira and Fernandes, 2009, using a severity of 1.0.
+//
+//nolint:gochecknoglobals
+var visionMatrix = map[Vision][3][3]float64{
+	Protanopia: {
+		{0.152286, 1.052583, -0.204868},
+		{0.114503, 0.786281, 0.099216},
+		{-0.003882, -0.048116, 1.051998},
+	},
+	Deuteranopia: {
+		{0.367322, 0.860646, -0.227968},
+		{0.280085, 0.672501, 0.047413},
+		{-0.011820, 0.042940, 0.968881},
+	},
+	Tritanopia: {
+		{1.255528, -0.076749, -0.178779},
+		{-0.078411, 0.930809, 0.147602},
+		{0.004733, 0.691367, 0.303900},
+	},
+}
+
+// Simulate returns a copy of the colorset as it would be seen with the color vision deficiency,
+// so the accessibility of color heavy artwork can be previewed.
+// An unknown vision returns the colorset unchanged.
+//
+// To render a grid using the simulation, pass the result to [Grid.SetColors].
+func (c Colors) Simulate(v Vision) Colors {
+	m, ok := visionMatrix[v]
+	if !ok {
+		return c
+	}
+	var sim Colors
+	for i, color := range c {
+		r, g, b := color.RGB()
+		lin := [3]float64{linear(r), linear(g), linear(b)}
+		var out [3]uint8
+		for j, row := range m {
+			out[j] = gamma(row[0]*lin[0] + row[1]*lin[1] + row[2]*lin[2])
+		}
+		sim[i] = rgbColor(out[0], out[1], out[2])
+	}
+	return sim
+}
+
+// linear returns the sRGB channel value as a linear intensity between 0 and 1.
+//
+//nolint:mnd
+func linear(v uint8) float64 {
+	f := float64(v) / 255
+	if f <= 0.04045 {
+		return f / 12.92
+	}
+	return math.Pow((f+0.055)/1.055, 2.4)
+}
+
+// gamma returns the linear intensity as a sRGB channel value, clamping out of range intensities.
+//
+//nolint:mnd
+func gamma(f float64) uint8 {
+	f = min(max(f, 0), 1)
+	if f <= 0.0031308 {
+		f *= 12.92
+	} else {
+		f = 1.055*math.Pow(f, 1/2.4) - 0.055
+	}
+	return uint8(math.Round(f * 255))
+}
@@ -0,0 +1,30 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleColors_Simulate() {
+	colors := binbump.CGA().Simulate(binbump.Deuteranopia)
+	fmt.Println(colors[0], colors[2], colors[4], colors[15])
+	// Output: 000000 9f8e24 6b5e00 ffffff
+}
+
+func ExampleGrid_SetColors() {
+	data := []byte{0x41, 0x0c}
+	d := binbump.NewDecoder(80, 0, binbump.StandardCGA, nil)
+	if err := d.Read(bytes.NewReader(data)); err != nil {
+		panic(err)
+	}
+	g := d.Grid()
+	g.SetColors(g.Colors().Simulate(binbump.Protanopia))
+	var b bytes.Buffer
+	if err := d.Write(&b); err != nil {
+		panic(err)
+	}
+	fmt.Printf("%q", b.String())
+	// Output: "<div><span style=\"color:#847a53;background-color:#000000;\">A</span>\n</div>"
+}
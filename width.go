@@ -0,0 +1,101 @@
+package binbump
+
+import (
+	"cmp"
+	"slices"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// WidthGuess is a candidate width of a binary screen dump and the confidence
+// of the guess, which is a value between 0 and 1.
+type WidthGuess struct {
+	Width      int
+	Confidence float64
+}
+
+// GuessWidth analyzes the data of a binary screen dump and returns the candidate widths
+// ranked by confidence, highest first. If no widths are given, 80 and 160 columns are compared.
+//
+// Each width is scored by laying out the cells and measuring how well the rows line up,
+// using the continuity of the box-drawing characters, the vertical repetition of the
+// color attributes, and the number of columns that are blank from top to bottom.
+// As a screen laid out with double its width also lines up, the score of a width is
+// reduced by the score of half the width. A width that gives less than two rows of data
+// has no confidence.
+func GuessWidth(data []byte, widths ...int) []WidthGuess {
+	if len(widths) == 0 {
+		widths = []int{80, 160}
+	}
+	const half = 2
+	guesses := make([]WidthGuess, 0, len(widths))
+	total := 0.0
+	for _, w := range widths {
+		score := widthScore(data, w)
+		if w%half == 0 {
+			score = max(0, score-widthScore(data, w/half))
+		}
+		total += score
+		guesses = append(guesses, WidthGuess{Width: w, Confidence: score})
+	}
+	for i := range guesses {
+		if total > 0 {
+			guesses[i].Confidence /= total
+		}
+	}
+	slices.SortStableFunc(guesses, func(a, b WidthGuess) int {
+		return cmp.Compare(b.Confidence, a.Confidence)
+	})
+	return guesses
+}
+
+// widthScore returns the score of the data laid out with the width.
+func widthScore(data []byte, width int) float64 {
+	const pair = 2
+	if width <= 0 {
+		return 0
+	}
+	rows := len(data) / pair / width
+	if rows < pair {
+		return 0
+	}
+	cell := func(x, y int) (rune, byte) {
+		i := (y*width + x) * pair
+		return charmap.CodePage437.DecodeByte(data[i]), data[i+1]
+	}
+	var same, connected, broken, blankCols int
+	for x := range width {
+		empty := true
+		for y := range rows {
+			r, attr := cell(x, y)
+			if !space(r) {
+				empty = false
+			}
+			if y == rows-1 {
+				continue
+			}
+			below, battr := cell(x, y+1)
+			if attr == battr {
+				same++
+			}
+			a, ok := boxDrawing[r]
+			if !ok || a.down == noLine {
+				continue
+			}
+			if b, ok := boxDrawing[below]; ok && b.up != noLine {
+				connected++
+			} else {
+				broken++
+			}
+		}
+		if empty {
+			blankCols++
+		}
+	}
+	score := float64(same) / float64(width*(rows-1))
+	if connected+broken > 0 {
+		score += float64(connected) / float64(connected+broken)
+	}
+	score += float64(blankCols) / float64(width)
+	return score
+}
@@ -0,0 +1,38 @@
+package binbump_test
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleGuessWidth() {
+	g := binbump.NewGrid(80, 25, binbump.StandardCGA, nil)
+	g.Box(image.Rect(2, 1, 40, 12), binbump.DoubleLine, 11, 1)
+	g.Box(image.Rect(42, 1, 78, 24), binbump.SingleLine, 14, 4)
+	g.Print(4, 3, "A screen with 80 columns", 15, 1)
+	var b bytes.Buffer
+	if err := g.WriteBIN(&b); err != nil {
+		panic(err)
+	}
+	for _, guess := range binbump.GuessWidth(b.Bytes()) {
+		fmt.Printf("%d columns %.2f\n", guess.Width, guess.Confidence)
+	}
+	// Output: 80 columns 0.62
+	// 160 columns 0.38
+}
+
+func ExampleGuessWidth_wide() {
+	g := binbump.NewGrid(160, 25, binbump.StandardCGA, nil)
+	g.Fill(image.Rect(0, 0, 60, 25), binbump.Cell{Char: 0xb1, Attr: 0x19})
+	g.Box(image.Rect(62, 2, 150, 20), binbump.SingleLine, 14, 4)
+	g.Print(70, 5, "A screen with 160 columns", 15, 4)
+	var b bytes.Buffer
+	if err := g.WriteBIN(&b); err != nil {
+		panic(err)
+	}
+	fmt.Println(binbump.GuessWidth(b.Bytes())[0].Width, "columns")
+	// Output: 160 columns
+}
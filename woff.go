@@ -0,0 +1,672 @@
+package binbump
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf16"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// WOFF returns the font converted to a vector web font in the WOFF format, with each
+// pixel of the bitmaps drawn as a square outline, for use with a CSS @font-face rule.
+// The characters are mapped to Unicode using the charset, where a nil charset uses
+// IBM Code Page 437. The name is the font family name.
+//
+// The font uses an em square the height of a glyph, so the pixels are crisp when the
+// font size is a whole multiple of the glyph height in CSS pixels.
+func (f *Font) WOFF(name string, charset *charmap.Charmap) ([]byte, error) {
+	return woff(f.sfnt(name, charset))
+}
+
+// WOFF2 returns the font converted to a vector web font in the WOFF2 format, like
+// [Font.WOFF], where the tables are compressed together by Brotli and the glyph outlines
+// use the transformed glyf and loca tables, so the font is smaller than the WOFF font.
+func (f *Font) WOFF2(name string, charset *charmap.Charmap) ([]byte, error) {
+	return woff2(f.sfnt(name, charset))
+}
+
+const (
+	fontUnit    = 64 // size of a pixel in font units
+	sfntVersion = 0x00010000
+)
+
+// sfntTable is a table of a TrueType font.
+type sfntTable struct {
+	tag  string
+	data []byte
+}
+
+// sfnt returns the tables of the font as a TrueType font.
+func (f *Font) sfnt(name string, charset *charmap.Charmap) []sfntTable {
+	if charset == nil {
+		charset = charmap.CodePage437
+	}
+	var (
+		be      = binary.BigEndian
+		descent = f.Height / 4 // the rows of pixels below the baseline
+		em      = f.Height * fontUnit
+		advance = f.Width * fontUnit
+		ascent  = em - descent*fontUnit
+	)
+	// glyph 0 is the empty .notdef glyph, followed by the glyphs of the characters
+	glyf, loca := []byte{}, make([]byte, 8)
+	hmtx := be.AppendUint16(nil, uint16(advance))
+	hmtx = be.AppendUint16(hmtx, 0)
+	maxPoints, maxContours := 0, 0
+	for code := range f.Glyphs {
+		g, lsb, contours := f.glyph(byte(code), ascent) //nolint:gosec
+		glyf = append(glyf, g...)
+		loca = be.AppendUint32(loca, uint32(len(glyf))) //nolint:gosec
+		hmtx = be.AppendUint16(hmtx, uint16(advance))
+		hmtx = be.AppendUint16(hmtx, uint16(lsb)) //nolint:gosec
+		maxPoints, maxContours = max(maxPoints, contours*4), max(maxContours, contours)
+	}
+	numGlyphs := len(f.Glyphs) + 1
+
+	head := be.AppendUint32(nil, sfntVersion)
+	head = be.AppendUint32(head, sfntVersion) // font revision
+	head = be.AppendUint32(head, 0)           // checksum adjustment, set by woff
+	head = be.AppendUint32(head, 0x5f0f3cf5)  // magic number
+	head = be.AppendUint16(head, 0x000b)      // baseline at 0, lsb at 0, integer scaling
+	head = be.AppendUint16(head, uint16(em))
+	head = append(head, make([]byte, 16)...) // created and modified dates
+	head = appendInt16s(head, 0, -descent*fontUnit, advance, ascent)
+	head = appendInt16s(head, 0, 8, 2, 1, 0) // style, lowest ppem, direction, long loca, format
+
+	hhea := be.AppendUint32(nil, sfntVersion)
+	hhea = appendInt16s(hhea, ascent, -descent*fontUnit, 0, advance, 0, 0, advance, 1, 0, 0)
+	hhea = appendInt16s(hhea, 0, 0, 0, 0, 0, numGlyphs)
+
+	maxp := be.AppendUint32(nil, sfntVersion)
+	maxp = appendInt16s(maxp, numGlyphs, maxPoints, maxContours, 0, 0, 2)
+	maxp = append(maxp, make([]byte, 16)...)
+
+	os2 := appendInt16s(nil, 4, advance, 400, 5, 0)
+	os2 = appendInt16s(os2, advance/2, em/2, 0, em/8, advance/2, em/2, 0, em/2)
+	os2 = appendInt16s(os2, fontUnit, em/4, 0)
+	os2 = append(os2, 2, 0, 0, 9, 0, 0, 0, 0, 0, 0) // panose, monospaced
+	os2 = binary.BigEndian.AppendUint32(os2, 1)     // basic latin unicode range
+	os2 = append(os2, make([]byte, 12)...)
+	os2 = append(os2, "NONE"...)
+	first, last := charsetRange(charset)
+	os2 = appendInt16s(os2, 0x40, int(first), int(last)) // regular
+	os2 = appendInt16s(os2, ascent, -descent*fontUnit, 0, ascent, descent*fontUnit)
+	os2 = be.AppendUint32(os2, 1) // latin 1 code page
+	os2 = be.AppendUint32(os2, 0)
+	os2 = appendInt16s(os2, em/2, ascent, 0, ' ', 1)
+
+	post := be.AppendUint32(nil, 0x00030000)
+	post = be.AppendUint32(post, 0)
+	post = appendInt16s(post, -fontUnit, fontUnit)
+	post = be.AppendUint32(post, 1) // fixed pitch
+	post = append(post, make([]byte, 16)...)
+
+	return []sfntTable{
+		{"OS/2", os2},
+		{"cmap", cmap(charset)},
+		{"glyf", glyf},
+		{"head", head},
+		{"hhea", hhea},
+		{"hmtx", hmtx},
+		{"loca", loca},
+		{"maxp", maxp},
+		{"name", nameTable(name)},
+		{"post", post},
+	}
+}
+
+// glyph returns the TrueType outline of the glyph, with the left side bearing and the
+// number of contours. Each run of set pixels in a row is a rectangle, extended down
+// the rows with the same run, where the top of the first row is at the ascent.
+func (f *Font) glyph(code byte, ascent int) ([]byte, int, int) {
+	type rect struct{ x0, y0, x1, y1 int }
+	rects := []rect{}
+	used := make([][]bool, f.Height)
+	for y := range used {
+		used[y] = make([]bool, f.Width)
+	}
+	run := func(x, y int) int {
+		end := x
+		for end < f.Width && f.pixel(code, end, y) {
+			end++
+		}
+		return end
+	}
+	for y := range f.Height {
+		for x := 0; x < f.Width; x++ {
+			if used[y][x] || !f.pixel(code, x, y) {
+				continue
+			}
+			end := run(x, y)
+			bottom := y + 1
+			for bottom < f.Height && (x == 0 || !f.pixel(code, x-1, bottom)) &&
+				run(x, bottom) == end && !used[bottom][x] {
+				bottom++
+			}
+			for yy := y; yy < bottom; yy++ {
+				for xx := x; xx < end; xx++ {
+					used[yy][xx] = true
+				}
+			}
+			rects = append(rects, rect{x, y, end, bottom})
+			x = end
+		}
+	}
+	if len(rects) == 0 {
+		return nil, 0, 0
+	}
+	be := binary.BigEndian
+	xMin, yMin, xMax, yMax := f.Width, f.Height, 0, 0
+	for _, r := range rects {
+		xMin, yMin, xMax, yMax = min(xMin, r.x0), min(yMin, r.y0), max(xMax, r.x1), max(yMax, r.y1)
+	}
+	top := func(y int) int { return ascent - y*fontUnit }
+	g := appendInt16s(nil, len(rects), xMin*fontUnit, top(yMax), xMax*fontUnit, top(yMin))
+	for i := range rects {
+		g = be.AppendUint16(g, uint16(i*4+3)) //nolint:gosec
+	}
+	g = be.AppendUint16(g, 0) // no instructions
+	const onCurve = 0x01
+	g = append(g, bytes.Repeat([]byte{onCurve}, len(rects)*4)...)
+	// the points of each rectangle are clockwise from the bottom-left corner,
+	// with the coordinates stored as deltas from the previous point
+	px, py := 0, 0
+	xs, ys := []int{}, []int{}
+	for _, r := range rects {
+		for _, pt := range [4][2]int{
+			{r.x0, r.y1}, {r.x0, r.y0}, {r.x1, r.y0}, {r.x1, r.y1},
+		} {
+			x, y := pt[0]*fontUnit, top(pt[1])
+			xs, ys = append(xs, x-px), append(ys, y-py)
+			px, py = x, y
+		}
+	}
+	g = appendInt16s(g, xs...)
+	g = appendInt16s(g, ys...)
+	for len(g)%4 != 0 {
+		g = append(g, 0)
+	}
+	return g, xMin * fontUnit, len(rects)
+}
+
+// cmap returns the character to glyph mapping table of the charset, as a format 4
+// subtable for the Unicode characters of the basic multilingual plane.
+func cmap(charset *charmap.Charmap) []byte {
+	type mapping struct{ r, glyph int }
+	maps := []mapping{}
+	seen := map[rune]bool{}
+	for code := range 256 {
+		r := charset.DecodeByte(byte(code))
+		if r == 0 || r > 0xfffe || seen[r] {
+			continue
+		}
+		seen[r] = true
+		maps = append(maps, mapping{int(r), code + 1})
+	}
+	slices.SortFunc(maps, func(a, b mapping) int { return a.r - b.r })
+	maps = append(maps, mapping{0xffff, 0}) // the required final segment
+	segs := len(maps)
+	searchRange, entrySelector := 1, 0
+	for searchRange*2 <= segs {
+		searchRange, entrySelector = searchRange*2, entrySelector+1
+	}
+	const header = 14
+	length := header + segs*8 + 2
+	sub := appendInt16s(nil, 4, length, 0, segs*2, searchRange*2, entrySelector, (segs-searchRange)*2)
+	for _, m := range maps {
+		sub = appendInt16s(sub, m.r)
+	}
+	sub = appendInt16s(sub, 0)
+	for _, m := range maps {
+		sub = appendInt16s(sub, m.r)
+	}
+	for _, m := range maps {
+		sub = appendInt16s(sub, (m.glyph-m.r)&0xffff)
+	}
+	for range maps {
+		sub = appendInt16s(sub, 0)
+	}
+	// a single Windows Unicode BMP encoding record
+	t := appendInt16s(nil, 0, 1, 3, 1)
+	t = binary.BigEndian.AppendUint32(t, uint32(len(t)+4)) //nolint:gosec
+	return append(t, sub...)
+}
+
+// charsetRange returns the first and last Unicode characters of the charset,
+// limited to the basic multilingual plane.
+func charsetRange(charset *charmap.Charmap) (rune, rune) {
+	first, last := rune(0xffff), rune(0)
+	for code := range 256 {
+		r := charset.DecodeByte(byte(code))
+		if r == 0 || r > 0xfffe {
+			continue
+		}
+		first, last = min(first, r), max(last, r)
+	}
+	return first, last
+}
+
+// nameTable returns the naming table of the font family name.
+func nameTable(name string) []byte {
+	if name == "" {
+		name = "binbump"
+	}
+	postscript := strings.Map(func(r rune) rune {
+		if r <= ' ' || r > '~' || strings.ContainsRune("[](){}<>/%", r) {
+			return -1
+		}
+		return r
+	}, name)
+	if postscript == "" {
+		postscript = "binbump"
+	}
+	names := []struct {
+		id   int
+		text string
+	}{
+		{1, name}, {2, "Regular"}, {3, name + " Regular"}, {4, name}, {6, postscript},
+	}
+	const header, record = 6, 12
+	t := appendInt16s(nil, 0, len(names), header+len(names)*record)
+	var text []byte
+	for _, n := range names {
+		s := utf16.Encode([]rune(n.text))
+		// Windows platform, Unicode BMP encoding, US English
+		t = appendInt16s(t, 3, 1, 0x409, n.id, len(s)*2, len(text))
+		for _, u := range s {
+			text = binary.BigEndian.AppendUint16(text, u)
+		}
+	}
+	return append(t, text...)
+}
+
+// woff returns the TrueType font tables wrapped in the WOFF format,
+// with each table compressed by zlib when that makes it smaller.
+func woff(tables []sfntTable) ([]byte, error) {
+	be := binary.BigEndian
+	const woffHeader, woffRecord = 44, 20
+	// the table checksums exclude the checksum adjustment of head
+	sums := make([]uint32, len(tables))
+	for i, t := range tables {
+		sums[i] = checksum(t.data)
+	}
+	sfntSize := adjustChecksum(tables)
+	dir := []byte{}
+	data := []byte{}
+	offset := woffHeader + woffRecord*len(tables)
+	for i, t := range tables {
+		var z bytes.Buffer
+		zw := zlib.NewWriter(&z)
+		if _, err := zw.Write(t.data); err != nil {
+			return nil, fmt.Errorf("woff compress: %w", err)
+		}
+		if err := zw.Close(); err != nil {
+			return nil, fmt.Errorf("woff compress: %w", err)
+		}
+		stored := t.data
+		if z.Len() < len(t.data) {
+			stored = z.Bytes()
+		}
+		dir = append(dir, t.tag...)
+		dir = be.AppendUint32(dir, uint32(offset+len(data))) //nolint:gosec
+		dir = be.AppendUint32(dir, uint32(len(stored)))      //nolint:gosec
+		dir = be.AppendUint32(dir, uint32(len(t.data)))      //nolint:gosec
+		dir = be.AppendUint32(dir, sums[i])
+		data = append(data, stored...)
+		for len(data)%4 != 0 {
+			data = append(data, 0)
+		}
+	}
+	out := []byte("wOFF")
+	out = be.AppendUint32(out, sfntVersion)
+	out = be.AppendUint32(out, uint32(offset+len(data))) //nolint:gosec
+	out = appendInt16s(out, len(tables), 0)
+	out = be.AppendUint32(out, uint32(sfntSize)) //nolint:gosec
+	out = appendInt16s(out, 1, 0)                // font version
+	out = append(out, make([]byte, 20)...)       // no metadata or private data
+	out = append(out, dir...)
+	return append(out, data...), nil
+}
+
+// woff2Tags are the tags of the known tables of the WOFF2 table directory,
+// up to the last tag that is used by the fonts.
+var woff2Tags = []string{
+	"cmap", "head", "hhea", "hmtx", "maxp", "name", "OS/2", "post", "cvt ",
+	"fpgm", "glyf", "loca", "prep",
+}
+
+// woff2 returns the TrueType font tables wrapped in the WOFF2 format, with the data
+// of the tables compressed together by Brotli. The glyf table is transformed, and the
+// loca table is left for the decoder to rebuild from it.
+func woff2(tables []sfntTable) ([]byte, error) {
+	be := binary.BigEndian
+	const woff2Header, lossless, flags = 48, 1 << 11, 16
+	var glyf, loca, head []byte
+	for _, t := range tables {
+		switch t.tag {
+		case "glyf":
+			glyf = t.data
+		case "loca":
+			loca = t.data
+		case "head":
+			head = t.data
+		}
+	}
+	be.PutUint16(head[flags:], be.Uint16(head[flags:])|lossless)
+	sfntSize := adjustChecksum(tables)
+	// the loca table must follow the glyf table in the directory
+	ordered := make([]sfntTable, 0, len(tables))
+	for _, t := range tables {
+		switch t.tag {
+		case "loca":
+		case "glyf":
+			ordered = append(ordered, t, sfntTable{"loca", loca})
+		default:
+			ordered = append(ordered, t)
+		}
+	}
+	dir := []byte{}
+	data := []byte{}
+	for _, t := range ordered {
+		// transform version 0 is the null transform, except for glyf and loca
+		if i := slices.Index(woff2Tags, t.tag); i >= 0 {
+			dir = append(dir, byte(i))
+		} else {
+			const arbitraryTag = 63
+			dir = append(append(dir, arbitraryTag), t.tag...)
+		}
+		dir = appendBase128(dir, len(t.data))
+		switch t.tag {
+		case "glyf":
+			g, err := woff2Glyf(glyf, loca)
+			if err != nil {
+				return nil, err
+			}
+			dir = appendBase128(dir, len(g))
+			data = append(data, g...)
+		case "loca":
+			dir = appendBase128(dir, 0)
+		default:
+			data = append(data, t.data...)
+		}
+	}
+	compressed := brotli(data)
+	length := pad4(woff2Header + len(dir) + len(compressed))
+	out := []byte("wOF2")
+	out = be.AppendUint32(out, sfntVersion)
+	out = be.AppendUint32(out, uint32(length)) //nolint:gosec
+	out = appendInt16s(out, len(ordered), 0)
+	out = be.AppendUint32(out, uint32(sfntSize))        //nolint:gosec
+	out = be.AppendUint32(out, uint32(len(compressed))) //nolint:gosec
+	out = appendInt16s(out, 1, 0)                       // font version
+	out = append(out, make([]byte, 20)...)              // no metadata or private data
+	out = append(out, dir...)
+	out = append(out, compressed...)
+	return append(out, make([]byte, length-len(out))...), nil
+}
+
+// woff2Glyf returns the glyf table transformed for WOFF2, where the contour counts,
+// point counts, flags and coordinates of the simple glyphs are stored in separate
+// streams, and the bounding boxes are left for the decoder to calculate from the points.
+// The loca table uses the long format.
+func woff2Glyf(glyf, loca []byte) ([]byte, error) {
+	be := binary.BigEndian
+	numGlyphs := len(loca)/4 - 1
+	var contours, points, flags, coords, instructions []byte
+	for id := range numGlyphs {
+		g := glyf[be.Uint32(loca[id*4:]):be.Uint32(loca[id*4+4:])]
+		if len(g) == 0 {
+			contours = appendInt16s(contours, 0)
+			continue
+		}
+		pts, ins, err := glyphPoints(g)
+		if err != nil {
+			return nil, fmt.Errorf("woff2 glyph %d: %w", id, err)
+		}
+		contours = append(contours, g[:2]...)
+		for _, n := range pts.ends {
+			points = append255UInt16(points, n)
+		}
+		px, py := 0, 0
+		for _, p := range pts.points {
+			flag, triplet := woff2Triplet(p.x-px, p.y-py)
+			if !p.on {
+				flag |= 0x80
+			}
+			flags = append(flags, flag)
+			coords = append(coords, triplet...)
+			px, py = p.x, p.y
+		}
+		coords = append255UInt16(coords, len(ins))
+		instructions = append(instructions, ins...)
+	}
+	// every bit of the bounding box bitmap is clear
+	bbox := make([]byte, (numGlyphs+31)/32*4)
+	const longLoca = 1
+	t := appendInt16s(nil, 0, 0, numGlyphs, longLoca) // no option flags
+	for _, stream := range [][]byte{contours, points, flags, coords, nil, bbox, instructions} {
+		t = be.AppendUint32(t, uint32(len(stream))) //nolint:gosec
+	}
+	for _, stream := range [][]byte{contours, points, flags, coords, bbox, instructions} {
+		t = append(t, stream...)
+	}
+	return t, nil
+}
+
+// glyphPoint is a point of a TrueType glyph outline.
+type glyphPoint struct {
+	x, y int
+	on   bool // the point is on the curve
+}
+
+// glyphOutline is the outline of a simple TrueType glyph,
+// with the number of points of each contour.
+type glyphOutline struct {
+	ends   []int
+	points []glyphPoint
+}
+
+// glyphPoints returns the outline and the instructions of the simple glyph data.
+func glyphPoints(g []byte) (glyphOutline, []byte, error) {
+	be := binary.BigEndian
+	var o glyphOutline
+	const header = 10
+	if len(g) < header {
+		return o, nil, io.ErrUnexpectedEOF
+	}
+	n := int(int16(be.Uint16(g))) //nolint:gosec
+	if n < 0 {
+		return o, nil, ErrFont
+	}
+	if len(g) < header+n*2+2 {
+		return o, nil, io.ErrUnexpectedEOF
+	}
+	count := 0
+	for i := range n {
+		end := int(be.Uint16(g[header+i*2:])) + 1
+		o.ends = append(o.ends, end-count)
+		count = end
+	}
+	g = g[header+n*2:]
+	insLen := int(be.Uint16(g))
+	if len(g) < 2+insLen {
+		return o, nil, io.ErrUnexpectedEOF
+	}
+	ins, g := g[2:2+insLen], g[2+insLen:]
+	const (
+		onCurve = 1 << iota
+		xShort
+		yShort
+		repeat
+		xSame
+		ySame
+	)
+	flags := []byte{}
+	for len(flags) < count {
+		if len(g) == 0 {
+			return o, nil, io.ErrUnexpectedEOF
+		}
+		f := g[0]
+		g = g[1:]
+		flags = append(flags, f)
+		if f&repeat != 0 && len(g) > 0 {
+			flags = append(flags, bytes.Repeat([]byte{f}, int(g[0]))...)
+			g = g[1:]
+		}
+	}
+	flags = flags[:count]
+	coord := func(short, same byte) ([]int, error) {
+		vs := make([]int, count)
+		v := 0
+		for i, f := range flags {
+			switch {
+			case f&short != 0 && len(g) > 0:
+				d := int(g[0])
+				if f&same == 0 {
+					d = -d
+				}
+				v, g = v+d, g[1:]
+			case f&short != 0:
+				return nil, io.ErrUnexpectedEOF
+			case f&same != 0:
+			case len(g) > 1:
+				v, g = v+int(int16(be.Uint16(g))), g[2:] //nolint:gosec
+			default:
+				return nil, io.ErrUnexpectedEOF
+			}
+			vs[i] = v
+		}
+		return vs, nil
+	}
+	xs, err := coord(xShort, xSame)
+	if err != nil {
+		return o, nil, err
+	}
+	ys, err := coord(yShort, ySame)
+	if err != nil {
+		return o, nil, err
+	}
+	for i, f := range flags {
+		o.points = append(o.points, glyphPoint{xs[i], ys[i], f&onCurve != 0})
+	}
+	return o, ins, nil
+}
+
+// woff2Triplet returns the flag and the data of the WOFF2 triplet encoding of a point,
+// which is the delta from the previous point.
+func woff2Triplet(dx, dy int) (byte, []byte) {
+	positive := func(v int, bit byte) byte {
+		if v >= 0 {
+			return bit
+		}
+		return 0
+	}
+	ax, ay := abs(dx), abs(dy)
+	const wordFlag, byteRange = 124, 1280
+	switch {
+	case dx == 0 && ay < byteRange:
+		return byte(ay>>8<<1) | positive(dy, 1), []byte{byte(ay)}
+	case dy == 0 && ax < byteRange:
+		return 10 + byte(ax>>8<<1) | positive(dx, 1), []byte{byte(ax)}
+	}
+	return wordFlag | positive(dx, 1) | positive(dy, 2),
+		[]byte{byte(ax >> 8), byte(ax), byte(ay >> 8), byte(ay)}
+}
+
+// append255UInt16 appends the value in the variable length 255UInt16 encoding of WOFF2.
+func append255UInt16(b []byte, v int) []byte {
+	const oneMoreByte1, oneMoreByte2, word = 255, 254, 253
+	switch {
+	case v < 253:
+		return append(b, byte(v))
+	case v < 506:
+		return append(b, oneMoreByte1, byte(v-253))
+	case v < 762:
+		return append(b, oneMoreByte2, byte(v-506))
+	}
+	return binary.BigEndian.AppendUint16(append(b, word), uint16(v)) //nolint:gosec
+}
+
+// appendBase128 appends the value in the variable length UIntBase128 encoding of WOFF2.
+func appendBase128(b []byte, v int) []byte {
+	n := 1
+	for v>>(7*n) != 0 {
+		n++
+	}
+	for i := n - 1; i >= 0; i-- {
+		c := byte(v >> (7 * i) & 0x7f)
+		if i > 0 {
+			c |= 0x80
+		}
+		b = append(b, c)
+	}
+	return b
+}
+
+// adjustChecksum sets the checksum adjustment of the head table, which is calculated
+// from the whole font, and returns the size of the font file.
+func adjustChecksum(tables []sfntTable) int {
+	sfnt := sfntData(tables)
+	for _, t := range tables {
+		if t.tag == "head" {
+			const adjustment, base = 8, 0xb1b0afba
+			binary.BigEndian.PutUint32(t.data[adjustment:], base-checksum(sfnt))
+		}
+	}
+	return len(sfnt)
+}
+
+// sfntData returns the tables as the data of a TrueType font file.
+func sfntData(tables []sfntTable) []byte {
+	const sfntHeader, sfntRecord = 12, 16
+	searchRange, entrySelector := 1, 0
+	for searchRange*2 <= len(tables) {
+		searchRange, entrySelector = searchRange*2, entrySelector+1
+	}
+	b := binary.BigEndian.AppendUint32(nil, sfntVersion)
+	b = appendInt16s(b, len(tables), searchRange*sfntRecord, entrySelector,
+		(len(tables)-searchRange)*sfntRecord)
+	offset := sfntHeader + sfntRecord*len(tables)
+	for _, t := range tables {
+		b = append(b, t.tag...)
+		b = binary.BigEndian.AppendUint32(b, checksum(t.data))
+		b = binary.BigEndian.AppendUint32(b, uint32(offset))      //nolint:gosec
+		b = binary.BigEndian.AppendUint32(b, uint32(len(t.data))) //nolint:gosec
+		offset += pad4(len(t.data))
+	}
+	for _, t := range tables {
+		b = append(b, t.data...)
+		b = append(b, make([]byte, pad4(len(t.data))-len(t.data))...)
+	}
+	return b
+}
+
+// checksum returns the TrueType table checksum, the sum of the data as 32-bit words.
+func checksum(data []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(data); i += 4 {
+		var word [4]byte
+		copy(word[:], data[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
+
+// pad4 returns n rounded up to a multiple of 4.
+func pad4(n int) int {
+	return (n + 3) &^ 3
+}
+
+// appendInt16s appends the values as big-endian 16-bit integers.
+func appendInt16s(b []byte, values ...int) []byte {
+	for _, v := range values {
+		b = binary.BigEndian.AppendUint16(b, uint16(v)) //nolint:gosec
+	}
+	return b
+}
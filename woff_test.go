@@ -0,0 +1,47 @@
+package binbump_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/bengarrett/binbump"
+)
+
+func ExampleFont_WOFF() {
+	font, err := binbump.ParseFont(bytes.Repeat([]byte{0x3c}, 256*16))
+	if err != nil {
+		panic(err)
+	}
+	woff, err := font.WOFF("VGA", nil)
+	if err != nil {
+		panic(err)
+	}
+	tables := int(binary.BigEndian.Uint16(woff[12:]))
+	fmt.Printf("%s %d tables\n", woff[:4], tables)
+	for i := range tables {
+		fmt.Printf("%s ", woff[44+i*20:48+i*20])
+	}
+	// Output: wOFF 10 tables
+	// OS/2 cmap glyf head hhea hmtx loca maxp name post
+}
+
+func ExampleFont_WOFF2() {
+	font, err := binbump.ParseFont(bytes.Repeat([]byte{0x3c}, 256*16))
+	if err != nil {
+		panic(err)
+	}
+	woff2, err := font.WOFF2("VGA", nil)
+	if err != nil {
+		panic(err)
+	}
+	woff, err := font.WOFF("VGA", nil)
+	if err != nil {
+		panic(err)
+	}
+	tables := int(binary.BigEndian.Uint16(woff2[12:]))
+	fmt.Printf("%s %d tables\n", woff2[:4], tables)
+	fmt.Println("smaller than WOFF:", len(woff2) < len(woff))
+	// Output: wOF2 10 tables
+	// smaller than WOFF: true
+}